@@ -0,0 +1,142 @@
+package starr
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/* This file contains a parser for the config.xml file every Starr app keeps in its AppData folder.
+ * Use it when your code runs on the same host as the app, to build a Config without asking for
+ * the port, URL base and API key. */
+
+// ConfigXMLFile is the name of the settings file in a Starr app's AppData folder.
+const ConfigXMLFile = "config.xml"
+
+// ErrUnknownApp is returned by NewFromConfigXML when the app a config.xml belongs to cannot be detected.
+var ErrUnknownApp = errors.New("cannot detect which app the config file belongs to")
+
+// defaultPorts are the default http ports for each app. Used to detect the app.
+var defaultPorts = map[int]App{ //nolint:gochecknoglobals
+	8686: Lidarr,
+	9696: Prowlarr,
+	7878: Radarr,
+	8787: Readarr,
+	8989: Sonarr,
+	6969: Whisparr,
+}
+
+// AppConfig is the data from a Starr app's config.xml file.
+// Only the settings needed to connect to the app are included.
+type AppConfig struct {
+	XMLName                xml.Name `xml:"Config"`
+	BindAddress            string   `xml:"BindAddress"`
+	Port                   int      `xml:"Port"`
+	SslPort                int      `xml:"SslPort"`
+	EnableSsl              bool     `xml:"EnableSsl"`
+	URLBase                string   `xml:"UrlBase"`
+	APIKey                 string   `xml:"ApiKey"`
+	AuthenticationMethod   string   `xml:"AuthenticationMethod"`
+	AuthenticationRequired string   `xml:"AuthenticationRequired"`
+	Branch                 string   `xml:"Branch"`
+	LogLevel               string   `xml:"LogLevel"`
+	InstanceName           string   `xml:"InstanceName"`
+	// App is detected, and is empty if detection failed. It is not in the config file.
+	App App `xml:"-"`
+	// Path is the full path to the config file that was parsed. It is not in the config file.
+	Path string `xml:"-"`
+}
+
+// ParseConfigXML reads a Starr app's config.xml file. The path may be the file, or the folder containing it.
+// The app is detected from the database file next to the config file, the instance name,
+// the folder name, or the default port, in that order. Check AppConfig.App for the result.
+func ParseConfigXML(filePath string) (*AppConfig, error) {
+	if info, err := os.Stat(filePath); err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	} else if info.IsDir() {
+		filePath = filepath.Join(filePath, ConfigXMLFile)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	config := &AppConfig{Path: filePath}
+	if err = xml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("decoding config file %s: %w", filePath, err)
+	}
+
+	config.App = config.detectApp()
+
+	return config, nil
+}
+
+// NewFromConfigXML reads a Starr app's config.xml file and returns a Config with the app's local URL and API key.
+// The path may be the file, or the folder containing it. The detected app is also returned.
+// ErrUnknownApp is returned with a valid Config when the app cannot be detected.
+func NewFromConfigXML(filePath string, timeout time.Duration) (*Config, App, error) {
+	appConfig, err := ParseConfigXML(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config := New(appConfig.APIKey, appConfig.URL(), timeout)
+	if appConfig.App == "" {
+		return config, "", ErrUnknownApp
+	}
+
+	return config, appConfig.App, nil
+}
+
+// URL returns the local URL for the app, including the URL base. Apps listening on every
+// address are reached on localhost. The SSL port is used when SSL is enabled.
+func (a *AppConfig) URL() string {
+	scheme, port := "http", a.Port
+	if a.EnableSsl && a.SslPort != 0 {
+		scheme, port = "https", a.SslPort
+	}
+
+	host := strings.Trim(a.BindAddress, "[]")
+	if host == "" || host == "*" || net.ParseIP(host).IsUnspecified() {
+		host = "localhost"
+	}
+
+	urlBase := strings.Trim(a.URLBase, "/")
+	if urlBase != "" {
+		urlBase += "/"
+	}
+
+	return scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port)) + "/" + urlBase
+}
+
+// detectApp figures out which app a config file belongs to.
+func (a *AppConfig) detectApp() App {
+	apps := []App{Lidarr, Prowlarr, Radarr, Readarr, Sonarr, Whisparr}
+	dir := filepath.Dir(a.Path)
+
+	// Each app keeps its database next to the config file.
+	for _, app := range apps {
+		if _, err := os.Stat(filepath.Join(dir, app.Lower()+".db")); err == nil {
+			return app
+		}
+	}
+
+	// The instance name is the app name unless the user changed it.
+	// The folder is usually named after the app, except in containers.
+	for _, name := range []string{a.InstanceName, filepath.Base(dir)} {
+		for _, app := range apps {
+			if strings.Contains(strings.ToLower(name), app.Lower()) {
+				return app
+			}
+		}
+	}
+
+	return defaultPorts[a.Port]
+}
@@ -0,0 +1,136 @@
+package starr_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+const configXML = `<Config>
+  <LogLevel>info</LogLevel>
+  <UrlBase>%s</UrlBase>
+  <UpdateMechanism>Docker</UpdateMechanism>
+  <BindAddress>%s</BindAddress>
+  <Port>%s</Port>
+  <SslPort>9898</SslPort>
+  <EnableSsl>%s</EnableSsl>
+  <LaunchBrowser>True</LaunchBrowser>
+  <ApiKey>0123456789abcdef0123456789abcdef</ApiKey>
+  <AuthenticationMethod>Forms</AuthenticationMethod>
+  <AuthenticationRequired>Enabled</AuthenticationRequired>
+  <Branch>main</Branch>
+  <SslCertPath></SslCertPath>
+  <SslCertPassword></SslCertPassword>
+  <InstanceName>%s</InstanceName>
+</Config>
+`
+
+func writeConfigXML(t *testing.T, dir string, values ...interface{}) string {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	filePath := filepath.Join(dir, starr.ConfigXMLFile)
+	require.NoError(t, os.WriteFile(filePath, []byte(fmt.Sprintf(configXML, values...)), 0o600))
+
+	return filePath
+}
+
+func TestParseConfigXML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		dir    string
+		db     string
+		values []interface{}
+		url    string
+		app    starr.App
+	}{
+		{
+			name:   "instance name",
+			dir:    "config",
+			values: []interface{}{"", "*", "8989", "False", "Sonarr"},
+			url:    "http://localhost:8989/",
+			app:    starr.Sonarr,
+		},
+		{
+			name:   "database file with ssl and url base",
+			dir:    "config",
+			db:     "radarr.db",
+			values: []interface{}{"/radarr/", "0.0.0.0", "7878", "True", "Movies 4K"},
+			url:    "https://localhost:9898/radarr/",
+			app:    starr.Radarr,
+		},
+		{
+			name:   "folder name with bind address",
+			dir:    "Lidarr",
+			values: []interface{}{"music", "192.168.1.10", "8000", "", "Music"},
+			url:    "http://192.168.1.10:8000/music/",
+			app:    starr.Lidarr,
+		},
+		{
+			name:   "default port with ipv6",
+			dir:    "data",
+			values: []interface{}{"", "::1", "9696", "False", "Indexers"},
+			url:    "http://[::1]:9696/",
+			app:    starr.Prowlarr,
+		},
+		{
+			name:   "unknown",
+			dir:    "data",
+			values: []interface{}{"", "[::]", "1234", "False", "Something"},
+			url:    "http://localhost:1234/",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := filepath.Join(t.TempDir(), test.dir)
+			filePath := writeConfigXML(t, dir, test.values...)
+
+			if test.db != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, test.db), nil, 0o600))
+			}
+
+			appConfig, err := starr.ParseConfigXML(dir) // The folder works too.
+			require.NoError(t, err)
+			assert.Equal(t, filePath, appConfig.Path)
+			assert.Equal(t, test.app, appConfig.App)
+			assert.Equal(t, test.url, appConfig.URL())
+			assert.Equal(t, "Forms", appConfig.AuthenticationMethod)
+
+			config, app, err := starr.NewFromConfigXML(filePath, 0)
+			if test.app == "" {
+				require.ErrorIs(t, err, starr.ErrUnknownApp)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.app, app)
+			assert.Equal(t, test.url, config.URL)
+			assert.Equal(t, "0123456789abcdef0123456789abcdef", config.APIKey)
+			assert.NotNil(t, config.Client)
+		})
+	}
+}
+
+func TestParseConfigXMLErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := starr.ParseConfigXML(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	filePath := filepath.Join(t.TempDir(), starr.ConfigXMLFile)
+	require.NoError(t, os.WriteFile(filePath, []byte("<Config><Port>abc</Port></Config>"), 0o600))
+
+	_, _, err = starr.NewFromConfigXML(filePath, 0)
+	require.Error(t, err)
+}
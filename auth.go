@@ -0,0 +1,186 @@
+package starr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+/* This file contains the session handling for non-API paths, like initialize.js and backup downloads.
+ * Those paths do not accept an API key. They require a Forms login cookie, or Basic auth,
+ * depending on the authentication method configured in the app. When a Username is set, requests
+ * to non-API paths that get a 401, or a redirect to the login page, log in and try again once.
+ */
+
+// cookieJar makes sure the http client has a cookie jar, and returns it.
+func (c *Config) cookieJar() (http.CookieJar, error) {
+	if c.Client.Jar == nil {
+		jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		if err != nil {
+			return nil, fmt.Errorf("cookiejar.New(publicsuffix): %w", err)
+		}
+
+		c.Client.Jar = jar
+	}
+
+	return c.Client.Jar, nil
+}
+
+// SaveCookies writes the session cookies from the last login to a file, with their expiration,
+// path and other attributes. Cookies restored with LoadCookies are saved again. Expired cookies are not.
+// Use LoadCookies to restore them, and avoid logging in again after a restart.
+func (c *Config) SaveCookies(filePath string) error {
+	data, err := json.Marshal(unexpired(c.sessionCookies()))
+	if err != nil {
+		return fmt.Errorf("encoding cookies: %w", err)
+	}
+
+	if err = os.WriteFile(filePath, data, 0o600); err != nil { //nolint:gomnd
+		return fmt.Errorf("writing cookie file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadCookies reads session cookies from a file written by SaveCookies, and adds them to the
+// http client's cookie jar. A cookie jar is created if the client does not have one.
+// Expired cookies are skipped.
+func (c *Config) LoadCookies(filePath string) error {
+	appURL, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("parsing url: %w", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("reading cookie file: %w", err)
+	}
+
+	var cookies []*http.Cookie
+	if err = json.Unmarshal(data, &cookies); err != nil {
+		return fmt.Errorf("decoding cookie file: %w", err)
+	}
+
+	jar, err := c.cookieJar()
+	if err != nil {
+		return err
+	}
+
+	cookies = unexpired(cookies)
+	jar.SetCookies(appURL, cookies)
+	c.session.Store(cookies)
+
+	return nil
+}
+
+// sessionCookies returns the cookies from the last login, or the last LoadCookies.
+func (c *Config) sessionCookies() []*http.Cookie {
+	cookies, _ := c.session.Load().([]*http.Cookie)
+	return cookies
+}
+
+// loginCookies returns the cookies set by a login response. Max-Age is turned into
+// an expiration time, so the cookie does not live longer after it's saved and loaded.
+func loginCookies(header http.Header) []*http.Cookie {
+	cookies := (&http.Response{Header: header}).Cookies()
+
+	for _, cookie := range cookies {
+		if cookie.MaxAge > 0 {
+			cookie.Expires = time.Now().Add(time.Duration(cookie.MaxAge) * time.Second)
+			cookie.MaxAge = 0
+		}
+	}
+
+	return cookies
+}
+
+// unexpired returns the cookies that have not expired.
+func unexpired(cookies []*http.Cookie) []*http.Cookie {
+	output := []*http.Cookie{}
+	now := time.Now()
+
+	for _, cookie := range cookies {
+		if cookie != nil && cookie.MaxAge >= 0 && (cookie.Expires.IsZero() || cookie.Expires.After(now)) {
+			output = append(output, cookie)
+		}
+	}
+
+	return output
+}
+
+// canLogin returns true if a request may log in and try again when it is not authorized.
+func (c *Config) canLogin(req Request) bool {
+	uri := path.Join("/", req.URI)
+
+	return c.Username != "" && !strings.HasPrefix(uri, path.Join("/", API)+"/") && !strings.HasSuffix(uri, "/login")
+}
+
+// reqWithLogin makes a request to a non-API path. If the app says the request is not
+// authorized, it logs in and sends the request one more time.
+func (c *Config) reqWithLogin(ctx context.Context, method string, req Request) (*http.Response, error) {
+	var body []byte
+
+	// Keep the body so the request can be sent again.
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		req.Body = bytes.NewReader(body)
+	}
+
+	resp, err := c.do(ctx, method, req)
+	if !loginRequired(resp, err) {
+		return resp, err
+	}
+
+	closeResp(resp)
+
+	var reqErr *ReqError
+	if errors.As(err, &reqErr) && reqErr.Code == http.StatusUnauthorized &&
+		strings.HasPrefix(strings.ToLower(reqErr.Get("WWW-Authenticate")), "basic") {
+		// The app uses Basic authentication. Send the username and password from now on.
+		atomic.StoreInt32(&c.basic, 1)
+	} else if err = c.Login(ctx); err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Body = bytes.NewReader(body)
+	}
+
+	return c.do(ctx, method, req)
+}
+
+// loginRequired returns true if the response says the request is not authenticated.
+// That's a 401, or a redirect to the login page. If the http client follows redirects,
+// that's a response from the login page.
+func loginRequired(resp *http.Response, err error) bool {
+	var reqErr *ReqError
+
+	switch {
+	case errors.As(err, &reqErr) && reqErr.Code == http.StatusUnauthorized:
+		return true
+	case reqErr != nil && reqErr.Code >= http.StatusMultipleChoices && reqErr.Code < http.StatusBadRequest:
+		location, _ := url.Parse(reqErr.Get("Location"))
+		return location != nil && strings.HasSuffix(location.Path, "/login")
+	case err == nil && resp.Request != nil:
+		return strings.HasSuffix(resp.Request.URL.Path, "/login")
+	default:
+		return false
+	}
+}
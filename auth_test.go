@@ -0,0 +1,200 @@
+package starr_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+const initializeJS = `window.Sonarr = {
+  apiRoot: '/api/v3',
+  apiKey: 'abc123',
+  version: '4.0.0.0',
+  instanceName: 'Sonarr'
+};`
+
+// fakeLogin is a fake Starr app with Forms (or Basic) authentication on non-API paths.
+type fakeLogin struct {
+	*httptest.Server
+	mu      sync.Mutex
+	basic   bool
+	session int // increment to expire every session.
+	logins  int
+}
+
+func newFakeLogin(t *testing.T, basic bool) *fakeLogin {
+	t.Helper()
+
+	fake := &fakeLogin{basic: basic, session: 1}
+	fake.Server = httptest.NewServer(fake)
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+func (f *fakeLogin) expire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.session++
+}
+
+func (f *fakeLogin) loginCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.logins
+}
+
+func (f *fakeLogin) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if req.URL.Path == "/login" && req.Method == http.MethodPost {
+		f.logins++
+
+		if req.PostFormValue("username") != "admin" || req.PostFormValue("password") != "hunter2" {
+			http.Redirect(writer, req, "/login?returnUrl=/&loginFailed=true", http.StatusFound)
+			return
+		}
+
+		http.SetCookie(writer, &http.Cookie{
+			Name: "SonarrAuth", Value: fmt.Sprint("session", f.session), Path: "/", MaxAge: 3600, HttpOnly: true,
+		})
+		http.Redirect(writer, req, "/", http.StatusFound)
+
+		return
+	}
+
+	if f.basic {
+		if user, pass, ok := req.BasicAuth(); !ok || user != "admin" || pass != "hunter2" {
+			writer.Header().Set("WWW-Authenticate", `Basic realm="Sonarr"`)
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+	} else if cookie, err := req.Cookie("SonarrAuth"); err != nil || cookie.Value != fmt.Sprint("session", f.session) {
+		http.Redirect(writer, req, "/login?returnUrl="+req.URL.Path, http.StatusFound)
+		return
+	}
+
+	switch req.URL.Path {
+	case "/initialize.js":
+		_, _ = writer.Write([]byte(initializeJS))
+	case "/backup/manual/backup.zip":
+		_, _ = writer.Write([]byte("zip data"))
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestFormsRelogin(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeLogin(t, false)
+	config := starr.New("abc123", fake.URL, 0)
+	config.Username, config.Password = "admin", "hunter2"
+
+	// No explicit Login. The first request logs in.
+	js, err := config.GetInitializeJS(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "4.0.0.0", js.Version)
+	assert.Equal(t, 1, fake.loginCount())
+
+	js, err = config.GetInitializeJS(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Sonarr", js.InstanceName)
+	assert.Equal(t, 1, fake.loginCount(), "the session cookie must be reused")
+
+	fake.expire()
+
+	resp, err := config.Get(context.Background(), starr.Request{URI: "/backup/manual/backup.zip"})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 2, fake.loginCount(), "an expired session must log in again")
+}
+
+func TestFormsLoginFailed(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeLogin(t, false)
+	config := starr.New("abc123", fake.URL, 0)
+	config.Username, config.Password = "admin", "wrong"
+
+	_, err := config.GetInitializeJS(context.Background())
+	require.ErrorIs(t, err, starr.ErrRequestError)
+	assert.Equal(t, 1, fake.loginCount(), "login must only be tried once")
+
+	// Without a username there is no login; the redirect is returned as an error.
+	config.Username = ""
+	_, err = config.GetInitializeJS(context.Background())
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.Equal(t, 1, fake.loginCount())
+}
+
+func TestBasicRelogin(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeLogin(t, true)
+	config := starr.New("abc123", fake.URL, 0)
+	config.Username, config.Password = "admin", "hunter2"
+
+	js, err := config.GetInitializeJS(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", js.APIKey)
+	assert.Zero(t, fake.loginCount(), "basic auth does not use the login form")
+}
+
+func TestSaveLoadCookies(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeLogin(t, false)
+	file := filepath.Join(t.TempDir(), "cookies.json")
+
+	config := starr.New("abc123", fake.URL, 0)
+	config.Username, config.Password = "admin", "hunter2"
+	require.NoError(t, config.Login(context.Background()))
+	require.NoError(t, config.SaveCookies(file))
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	var saved []*http.Cookie
+	require.NoError(t, json.Unmarshal(data, &saved))
+	require.Len(t, saved, 1)
+	assert.Equal(t, "/", saved[0].Path, "cookie attributes must be saved")
+	assert.True(t, saved[0].HttpOnly, "cookie attributes must be saved")
+	assert.WithinDuration(t, time.Now().Add(time.Hour), saved[0].Expires, time.Minute, "max age must become an expiration")
+
+	restored := starr.New("abc123", fake.URL, 0)
+	restored.Username, restored.Password = "admin", "hunter2"
+	require.NoError(t, restored.LoadCookies(file))
+
+	_, err = restored.GetInitializeJS(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.loginCount(), "restored cookies must be used instead of logging in")
+
+	// Expired cookies are not loaded, so the next request logs in.
+	saved[0].Expires = time.Now().Add(-time.Minute)
+	data, err = json.Marshal(saved)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(file, data, 0o600))
+
+	expired := starr.New("abc123", fake.URL, 0)
+	expired.Username, expired.Password = "admin", "hunter2"
+	require.NoError(t, expired.LoadCookies(file))
+
+	_, err = expired.GetInitializeJS(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.loginCount(), "expired cookies must not be loaded")
+}
@@ -0,0 +1,224 @@
+package starr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+/* This file contains an opt-in response cache for API GET requests.
+ * Assign a cache to Config.Cache to use it. The cache stores raw response bodies,
+ * so every caller gets its own decoded copy. Writes made with the same Config
+ * (POST, PUT and DELETE) invalidate the cached responses for the resource they write to.
+ */
+
+// Cache stores API GET responses. Create one with NewCache, and assign it to Config.Cache.
+// A resource is the first path element after the API version, ie. series, movie, qualityprofile or tag.
+// Writes invalidate their own resource only. Use Purge after a write that changes other resources,
+// like a command that refreshes a series. A Cache is safe for concurrent use, and may be shared
+// by multiple Configs as long as they point to the same app instance.
+type Cache struct {
+	defaultTTL    time.Duration
+	ttls          map[string]time.Duration
+	mu            sync.Mutex
+	entries       map[string]*cacheEntry
+	hits          int64
+	misses        int64
+	invalidations int64
+	// generation changes on every purge, so responses requested before a write are not stored after it.
+	generation uint64
+}
+
+// CacheStats contains the cache counters. Retrieve them with Cache.Stats.
+type CacheStats struct {
+	// Hits is the number of requests answered from the cache.
+	Hits int64
+	// Misses is the number of cacheable requests sent to the app.
+	Misses int64
+	// Invalidations is the number of entries removed by writes and purges.
+	Invalidations int64
+	// Entries is the number of responses in the cache, including expired ones.
+	Entries int
+	// Bytes is the total size of the cached response bodies.
+	Bytes int
+}
+
+type cacheEntry struct {
+	resource string
+	body     []byte
+	expires  time.Time
+}
+
+// NewCache returns a response cache. The default TTL applies to resources that are not in the ttls map.
+// Set the default to 0 to only cache the resources in the map. Map keys are resource names, like
+// "series", "movie", "qualityprofile" or "tag". Keys are not case sensitive. A TTL of 0 disables caching.
+func NewCache(defaultTTL time.Duration, ttls map[string]time.Duration) *Cache {
+	cache := &Cache{
+		defaultTTL: defaultTTL,
+		ttls:       make(map[string]time.Duration, len(ttls)),
+		entries:    make(map[string]*cacheEntry),
+	}
+
+	for resource, ttl := range ttls {
+		cache.ttls[strings.ToLower(resource)] = ttl
+	}
+
+	return cache
+}
+
+// Purge removes the cached responses for the provided resources, or every cached response if none are provided.
+func (c *Cache) Purge(resources ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(resources) == 0 {
+		c.invalidations += int64(len(c.entries))
+		c.entries = make(map[string]*cacheEntry)
+		c.generation++
+
+		return
+	}
+
+	for _, resource := range resources {
+		c.purge(strings.ToLower(resource))
+	}
+}
+
+// Stats returns the current cache counters.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := CacheStats{
+		Hits:          c.hits,
+		Misses:        c.misses,
+		Invalidations: c.invalidations,
+		Entries:       len(c.entries),
+	}
+
+	for _, entry := range c.entries {
+		stats.Bytes += len(entry.body)
+	}
+
+	return stats
+}
+
+// purge removes every entry for a resource. The caller must hold the lock.
+func (c *Cache) purge(resource string) {
+	c.generation++
+
+	for key, entry := range c.entries {
+		if entry.resource == resource {
+			delete(c.entries, key)
+			c.invalidations++
+		}
+	}
+}
+
+func (c *Cache) ttl(resource string) time.Duration {
+	if ttl, ok := c.ttls[resource]; ok {
+		return ttl
+	}
+
+	return c.defaultTTL
+}
+
+// get returns a cached body, or the current generation to pass into set.
+func (c *Cache) get(key string) ([]byte, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if ok && time.Now().Before(entry.expires) {
+		c.hits++
+		return entry.body, c.generation, true
+	}
+
+	if ok {
+		delete(c.entries, key)
+	}
+
+	c.misses++
+
+	return nil, c.generation, false
+}
+
+// set stores a body, unless the cache was purged since the generation was retrieved.
+func (c *Cache) set(key, resource string, body []byte, ttl time.Duration, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	c.entries[key] = &cacheEntry{resource: resource, body: body, expires: time.Now().Add(ttl)}
+}
+
+// cacheResource returns the resource name for a request path, ie. /api/v3/series/1 returns series.
+func cacheResource(uri string) string {
+	parts := strings.Split(strings.Trim(SetAPIPath(uri), "/"), "/")
+	if len(parts) < 3 { //nolint:gomnd // api, version, resource.
+		return ""
+	}
+
+	return strings.ToLower(parts[2])
+}
+
+// invalidate removes the cached responses for the resource a write request changed.
+func (c *Config) invalidate(req Request) {
+	if c.Cache == nil {
+		return
+	}
+
+	if resource := cacheResource(req.URI); resource != "" {
+		c.Cache.mu.Lock()
+		defer c.Cache.mu.Unlock()
+
+		c.Cache.purge(resource)
+	}
+}
+
+// cachedGetInto is GetInto with a cache.
+func (c *Config) cachedGetInto(ctx context.Context, req Request, output interface{}) error {
+	resource := cacheResource(req.URI)
+
+	ttl := c.Cache.ttl(resource)
+	if ttl <= 0 || output == nil {
+		resp, err := c.api(ctx, http.MethodGet, req)
+		return decode(output, resp, err)
+	}
+
+	key := SetAPIPath(req.URI) + "?" + req.Query.Encode()
+	body, generation, ok := c.Cache.get(key)
+	if ok {
+		if err := json.Unmarshal(body, output); err != nil {
+			return fmt.Errorf("decoding cached Starr JSON response body: %w", err)
+		}
+
+		return nil
+	}
+
+	resp, err := c.api(ctx, http.MethodGet, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if body, err = io.ReadAll(resp.Body); err != nil {
+		return fmt.Errorf("reading Starr response body: %w", err)
+	}
+
+	if err = json.Unmarshal(body, output); err != nil {
+		return fmt.Errorf("decoding Starr JSON response body: %w", err)
+	}
+
+	c.Cache.set(key, resource, body, ttl, generation)
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package starr_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+// count returns how many times the fake server received a request.
+func count(fake *starrtest.FakeServer, request string) int {
+	total := 0
+
+	for _, req := range fake.Requests() {
+		if req == request {
+			total++
+		}
+	}
+
+	return total
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr(t, "mockAPIkey")
+	fake.Seed("tag", &starr.Tag{Label: "one"})
+
+	config := starr.New("mockAPIkey", fake.URL, 0)
+	config.Cache = starr.NewCache(time.Minute, map[string]time.Duration{"Series": 0})
+	client := sonarr.New(config)
+
+	for i := 0; i < 3; i++ {
+		tags, err := client.GetTags()
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		tags[0].Label = "modified" // Callers get their own copy.
+	}
+
+	assert.Equal(t, 1, count(fake, "GET /api/v3/tag"), "only the first request may reach the server")
+	assert.Equal(t, starr.CacheStats{Hits: 2, Misses: 1, Entries: 1, Bytes: 25}, config.Cache.Stats())
+
+	// A write invalidates the resource.
+	_, err := client.AddTag(&starr.Tag{Label: "two"})
+	require.NoError(t, err)
+
+	tags, err := client.GetTags()
+	require.NoError(t, err)
+	assert.Len(t, tags, 2, "the cache must be invalidated by the write")
+	assert.Equal(t, 2, count(fake, "GET /api/v3/tag"))
+	assert.EqualValues(t, 1, config.Cache.Stats().Invalidations)
+
+	config.Cache.Purge()
+	assert.Zero(t, config.Cache.Stats().Entries)
+
+	// A TTL of 0 disables caching.
+	for i := 0; i < 2; i++ {
+		_, err = client.GetAllSeries()
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, count(fake, "GET /api/v3/series"))
+}
+
+func TestCacheExpire(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr(t, "mockAPIkey")
+	config := starr.New("mockAPIkey", fake.URL, 0)
+	config.Cache = starr.NewCache(0, map[string]time.Duration{"tag": time.Millisecond})
+	client := sonarr.New(config)
+
+	_, err := client.GetTags()
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = client.GetTags()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, count(fake, "GET /api/v3/tag"), "expired entries must not be used")
+	assert.EqualValues(t, 2, config.Cache.Stats().Misses)
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr(t, "mockAPIkey")
+	config := starr.New("mockAPIkey", fake.URL, 0)
+	config.Cache = starr.NewCache(time.Minute, nil)
+	client := sonarr.New(config)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.GetTags()
+			assert.NoError(t, err)
+			_, err = client.AddTag(&starr.Tag{Label: time.Now().String()})
+			assert.NoError(t, err)
+			config.Cache.Purge("tag")
+		}()
+	}
+
+	wg.Wait()
+
+	tags, err := client.GetTags()
+	require.NoError(t, err)
+	assert.Len(t, tags, 10)
+}
@@ -0,0 +1,129 @@
+package starr_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/debuglog"
+)
+
+func statusHandler(writer http.ResponseWriter, _ *http.Request) {
+	_, _ = writer.Write([]byte(`{"version":"4.0.0.0"}`))
+}
+
+func getStatus(t *testing.T, client *http.Client, appURL string) error {
+	t.Helper()
+
+	config := starr.New("mockAPIkey", appURL, 0)
+	config.Client = client
+
+	return config.GetInto(context.Background(), starr.Request{URI: "/system/status"}, &struct{}{})
+}
+
+func TestWithCAPool(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(statusHandler))
+	defer server.Close()
+
+	err := getStatus(t, starr.Client(0, true), server.URL)
+	require.Error(t, err, "the test server's certificate is not trusted by the system")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, pemCert, 0o600))
+
+	pool, err := starr.CAPoolFromFile(caFile)
+	require.NoError(t, err)
+
+	// Providing a CA pool turns on verification, so it works with New.
+	config := starr.New("mockAPIkey", server.URL, 0, starr.WithCAPool(pool))
+	require.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/system/status"}, &struct{}{}))
+
+	_, err = starr.CAPoolFromPEM([]byte("not a certificate"))
+	require.ErrorIs(t, err, starr.ErrNoCertificates)
+}
+
+func TestWithClientCert(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(statusHandler))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	require.Error(t, getStatus(t, starr.Client(0, false), server.URL), "a client certificate is required")
+
+	// The server's own certificate is good enough for a client certificate.
+	var logged string
+
+	logConfig := debuglog.Config{Debugf: func(msg string, _ ...interface{}) { logged = msg }}
+	client := starr.ClientWithDebug(0, false, logConfig, starr.WithClientCert(server.TLS.Certificates[0]))
+	require.NoError(t, getStatus(t, client, server.URL))
+	assert.Contains(t, logged, "200 OK")
+}
+
+func TestWithPinnedCert(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(statusHandler))
+	defer server.Close()
+
+	sum := sha256.Sum256(server.Certificate().Raw)
+	fingerprint := strings.ToUpper(hex.EncodeToString(sum[:]))
+	colons := make([]string, 0, len(sum))
+
+	for i := 0; i < len(fingerprint); i += 2 {
+		colons = append(colons, fingerprint[i:i+2])
+	}
+
+	client, err := starr.NewClient(0, false, starr.WithPinnedCert(strings.Repeat("00", sha256.Size), strings.Join(colons, ":")))
+	require.NoError(t, err)
+	require.NoError(t, getStatus(t, client, server.URL))
+
+	client = starr.Client(0, false, starr.WithPinnedCert(strings.Repeat("00", sha256.Size)))
+	require.ErrorIs(t, getStatus(t, client, server.URL), starr.ErrCertPinMismatch)
+
+	for _, bad := range []string{"bad", "AB:CD", fingerprint + "0"} {
+		_, err = starr.NewClient(0, false, starr.WithPinnedCert(bad))
+		require.ErrorIs(t, err, starr.ErrInvalidFingerprint, bad)
+	}
+
+	// The option error is returned by every request, and not a misleading handshake error.
+	client = starr.Client(0, false, starr.WithPinnedCert("bad", fingerprint))
+	require.ErrorIs(t, getStatus(t, client, server.URL), starr.ErrInvalidFingerprint)
+}
+
+func TestWithProxy(t *testing.T) {
+	t.Parallel()
+
+	var proxied string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		proxied = req.URL.String() // Proxies receive the full URL.
+		statusHandler(writer, req)
+	}))
+	defer proxy.Close()
+
+	client := starr.Client(0, false, starr.WithProxy(proxy.URL))
+	require.NoError(t, getStatus(t, client, "http://sonarr.invalid:8989"))
+	assert.Equal(t, "http://sonarr.invalid:8989/api/system/status", proxied)
+
+	_, err := starr.NewClient(0, false, starr.WithProxy("://bad"))
+	require.ErrorContains(t, err, "parsing proxy url")
+
+	client = starr.Client(0, false, starr.WithProxy("://bad"))
+	require.ErrorContains(t, getStatus(t, client, "http://sonarr.invalid:8989"), "parsing proxy url")
+}
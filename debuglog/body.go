@@ -0,0 +1,79 @@
+package debuglog
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// redactedValue replaces secret query parameter values in logged and traced URLs.
+const redactedValue = "REDACTED"
+
+// secretParams are query parameters that always have their values redacted.
+var secretParams = []string{"apikey", "api_key", "access_token", "token", "password"} //nolint:gochecknoglobals
+
+// countingBody wraps a response body, counts the bytes read from it, and runs a callback when it's closed.
+type countingBody struct {
+	io.ReadCloser
+	rcvd    int
+	once    sync.Once
+	onClose func(rcvd int)
+}
+
+func (c *countingBody) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.rcvd += n
+
+	return n, err //nolint:wrapcheck
+}
+
+// Close closes the body and runs the callback once.
+func (c *countingBody) Close() error {
+	err := c.ReadCloser.Close()
+	c.once.Do(func() { c.onClose(c.rcvd) })
+
+	return err //nolint:wrapcheck
+}
+
+// sentBytes returns the size of a request body, or 0 if it's unknown.
+func sentBytes(req *http.Request) int {
+	if req.ContentLength > 0 {
+		return int(req.ContentLength)
+	}
+
+	return 0
+}
+
+// redactURL returns a URL string without user info, without secret query parameter
+// values, and with every string in the redact list (4+ chars) replaced.
+func redactURL(uri *url.URL, redact []string) string {
+	clean := *uri
+	clean.User = nil
+
+	if query := clean.Query(); len(query) > 0 {
+		for key := range query {
+			for _, secret := range secretParams {
+				if strings.EqualFold(key, secret) {
+					query.Set(key, redactedValue)
+				}
+			}
+		}
+
+		clean.RawQuery = query.Encode()
+	}
+
+	return redactString(clean.String(), redact)
+}
+
+func redactString(msg string, redact []string) string {
+	for _, secret := range redact {
+		if len(secret) >= minRedactChars {
+			msg = strings.ReplaceAll(msg, secret, redactedValue)
+			msg = strings.ReplaceAll(msg, url.QueryEscape(secret), redactedValue)
+		}
+	}
+
+	return msg
+}
@@ -0,0 +1,84 @@
+package debuglog
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryTracer is a Tracer that keeps every span in memory.
+// It is useful in tests, or to inspect requests without an OpenTelemetry exporter.
+type MemoryTracer struct {
+	mu    sync.Mutex
+	spans []*MemorySpan
+}
+
+// MemorySpan is a span recorded by a MemoryTracer.
+type MemorySpan struct {
+	Name        string
+	Attributes  map[string]interface{}
+	Status      StatusCode
+	Description string
+	Errors      []error
+	Start       time.Time
+	End         time.Time
+	tracer      *MemoryTracer
+}
+
+// MemoryTracer must satisfy the Tracer interface.
+var _ Tracer = (*MemoryTracer)(nil)
+
+// Start creates a new span.
+func (m *MemoryTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	return ctx, &memorySpan{&MemorySpan{
+		Name:       spanName,
+		Attributes: make(map[string]interface{}),
+		Start:      time.Now(),
+		tracer:     m,
+	}}
+}
+
+// Spans returns a copy of the list of ended spans.
+func (m *MemoryTracer) Spans() []*MemorySpan {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*MemorySpan{}, m.spans...)
+}
+
+// Reset removes all the recorded spans.
+func (m *MemoryTracer) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.spans = nil
+}
+
+// memorySpan satisfies the Span interface. Spans are only added to the tracer when they end.
+type memorySpan struct {
+	*MemorySpan
+}
+
+func (s *memorySpan) SetAttributes(attrs ...Attribute) {
+	for _, attr := range attrs {
+		s.Attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *memorySpan) SetStatus(code StatusCode, description string) {
+	s.Status = code
+	s.Description = description
+}
+
+func (s *memorySpan) RecordError(err error) {
+	s.Errors = append(s.Errors, err)
+}
+
+func (s *memorySpan) End() {
+	s.MemorySpan.End = time.Now()
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+
+	s.tracer.spans = append(s.tracer.spans, s.MemorySpan)
+}
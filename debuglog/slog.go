@@ -0,0 +1,140 @@
+//go:build go1.21
+
+package debuglog
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SlogConfig is the input data for the structured logger.
+type SlogConfig struct {
+	// This is where logs go. If not set they go to slog.Default().
+	Logger *slog.Logger
+	// Requests are logged at this level. Failed requests, and 4xx and 5xx responses,
+	// are always logged at slog.LevelError.
+	Level slog.Level
+	// App is added to every log line as the "app" attribute, ie. "Sonarr".
+	App string
+	// Log JSON request and response bodies in the "sentBody" and "rcvdBody" attributes.
+	Bodies bool
+	// Limit logged JSON payloads to this many bytes. 0=unlimited
+	MaxBody int
+	// This can be used for byte counters, but is optional otherwise.
+	Caller Caller
+	// Any strings in this list are replaced with REDACTED in the path and body attributes.
+	// The values of secret query parameters, like apikey, are always redacted. String must be 4+ chars.
+	Redact []string
+}
+
+// SlogRoundTripper logs every request with structured attributes:
+// app, method, path, status, latency, sent and rcvd (bytes), and error.
+type SlogRoundTripper struct {
+	next   http.RoundTripper
+	config *SlogConfig
+}
+
+// NewSlogRoundTripper returns a round tripper that logs requests with log/slog.
+func NewSlogRoundTripper(config SlogConfig, next http.RoundTripper) *SlogRoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return &SlogRoundTripper{
+		next:   next,
+		config: &config,
+	}
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (rt *SlogRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var sent bytes.Buffer
+
+	if rt.config.Bodies && req.Body != nil {
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.TeeReader(req.Body, &sent), req.Body}
+	}
+
+	start := time.Now()
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		rt.log(req, 0, "000 Failed", start, sent.String(), "", sentBytes(req), 0, err)
+		return resp, err //nolint:wrapcheck
+	}
+
+	var rcvd bytes.Buffer
+
+	reader := resp.Body
+	if rt.config.Bodies && strings.Contains(resp.Header.Get("content-type"), "json") {
+		reader = struct {
+			io.Reader
+			io.Closer
+		}{io.TeeReader(resp.Body, &rcvd), resp.Body}
+	}
+
+	resp.Body = &countingBody{ReadCloser: reader, onClose: func(rcvdBytes int) {
+		rt.log(req, resp.StatusCode, resp.Status, start, sent.String(), rcvd.String(), sentBytes(req), rcvdBytes, nil)
+	}}
+
+	return resp, nil
+}
+
+func (rt *SlogRoundTripper) log(req *http.Request, code int, status string, start time.Time,
+	sent, rcvd string, sentLen, rcvdLen int, err error,
+) {
+	if rt.config.Caller != nil {
+		rt.config.Caller(status, req.Method, sentLen, rcvdLen, err)
+	}
+
+	// Requests that fail, or get a 4xx or 5xx response, are errors.
+	level := rt.config.Level
+	if err != nil || code >= http.StatusBadRequest {
+		level = slog.LevelError
+	}
+
+	ctx := req.Context()
+	if !rt.config.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("app", rt.config.App),
+		slog.String("method", req.Method),
+		slog.String("path", redactURL(req.URL, rt.config.Redact)),
+		slog.String("status", status),
+		slog.Duration("latency", time.Since(start)),
+		slog.Int("sent", sentLen),
+		slog.Int("rcvd", rcvdLen),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	if rt.config.Bodies {
+		attrs = append(attrs,
+			slog.String("sentBody", rt.truncate(sent, " <data truncated>")),
+			slog.String("rcvdBody", rt.truncate(rcvd, " <body truncated>")))
+	}
+
+	rt.config.Logger.LogAttrs(ctx, level, "starr request", attrs...)
+}
+
+func (rt *SlogRoundTripper) truncate(body, suffix string) string {
+	if rt.config.MaxBody > 0 && len(body) > rt.config.MaxBody {
+		body = body[:rt.config.MaxBody] + suffix
+	}
+
+	return redactString(body, rt.config.Redact)
+}
@@ -0,0 +1,78 @@
+//go:build go1.21
+
+package debuglog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/debuglog"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestSlogRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buf    bytes.Buffer
+		fake   = starrtest.NewFakeSonarr(t, "secretAPIkey")
+		config = starr.New("secretAPIkey", fake.URL, 0)
+		rcvd   = 0
+	)
+
+	config.Client.Transport = debuglog.NewSlogRoundTripper(debuglog.SlogConfig{
+		Logger: slog.New(slog.NewJSONHandler(&buf, nil)),
+		Level:  slog.LevelInfo,
+		App:    "Sonarr",
+		Bodies: true,
+		Redact: []string{"hunter22"},
+		Caller: func(_, _ string, _, rcvdBytes int, _ error) { rcvd += rcvdBytes },
+	}, nil)
+
+	_, err := sonarr.New(config).AddTag(&starr.Tag{Label: "hunter22"})
+	require.NoError(t, err)
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+
+	assert.Equal(t, "INFO", line["level"])
+	assert.Equal(t, "Sonarr", line["app"])
+	assert.Equal(t, http.MethodPost, line["method"])
+	assert.Equal(t, fake.URL+"/api/v3/tag", line["path"])
+	assert.Equal(t, "201 Created", line["status"])
+	assert.Contains(t, line, "latency")
+	assert.EqualValues(t, rcvd, line["rcvd"])
+	assert.NotZero(t, line["sent"])
+	assert.NotContains(t, buf.String(), "hunter22", "redacted strings must not be logged")
+	assert.NotContains(t, buf.String(), "secretAPIkey")
+}
+
+func TestSlogRoundTripperErrorLevel(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buf    bytes.Buffer
+		fake   = starrtest.NewFakeSonarr(t, "secretAPIkey")
+		config = starr.New("secretAPIkey", fake.URL, 0)
+	)
+
+	config.Client.Transport = debuglog.NewSlogRoundTripper(debuglog.SlogConfig{
+		Logger: slog.New(slog.NewJSONHandler(&buf, nil)),
+		Level:  slog.LevelInfo,
+	}, nil)
+
+	_, err := sonarr.New(config).GetTag(99)
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "ERROR", line["level"], "4xx responses must be logged as errors")
+	assert.Equal(t, "404 Not Found", line["status"])
+}
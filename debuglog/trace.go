@@ -0,0 +1,142 @@
+package debuglog
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+/* This file contains a tracing round tripper that is compatible with OpenTelemetry.
+ * This library does not import OpenTelemetry, so Tracer and Span are small interfaces
+ * that an OpenTelemetry tracer can satisfy with a tiny adapter. Attribute keys follow
+ * the OpenTelemetry HTTP client semantic conventions.
+ */
+
+// Attribute keys added to every span.
+const (
+	AttrApp        = "starr.app"
+	AttrMethod     = "http.request.method"
+	AttrURL        = "url.full"
+	AttrServer     = "server.address"
+	AttrStatusCode = "http.response.status_code"
+	AttrSentBytes  = "http.request.body.size"
+	AttrRcvdBytes  = "http.response.body.size"
+)
+
+// StatusCode is the status of a span. The values match OpenTelemetry's codes.
+type StatusCode int
+
+// These are the span status codes.
+const (
+	StatusUnset StatusCode = iota
+	StatusError
+	StatusOK
+)
+
+// Attribute is a span attribute.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts spans. Wrap an OpenTelemetry trace.Tracer to satisfy this interface.
+type Tracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span is a single traced request. Wrap an OpenTelemetry trace.Span to satisfy this interface.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	SetStatus(code StatusCode, description string)
+	RecordError(err error)
+	End()
+}
+
+// TraceConfig is the input data for the tracer.
+type TraceConfig struct {
+	// Tracer creates a span for every request. Required.
+	Tracer Tracer
+	// App is added to every span as the starr.app attribute, ie. "Radarr".
+	App string
+	// Inject is optional, and may add trace propagation headers to a request.
+	// With OpenTelemetry this is:
+	//   otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+	Inject func(ctx context.Context, header http.Header)
+	// This can be used for byte counters, but is optional otherwise.
+	Caller Caller
+	// Any strings in this list are replaced with REDACTED in the url.full attribute.
+	// The values of secret query parameters, like apikey, are always redacted. String must be 4+ chars.
+	Redact []string
+}
+
+// TracingRoundTripper creates a span for every request.
+// The span ends when the response body is closed, or when the request fails.
+type TracingRoundTripper struct {
+	next   http.RoundTripper
+	config *TraceConfig
+}
+
+// NewTracingRoundTripper returns a round tripper that traces requests.
+func NewTracingRoundTripper(config TraceConfig, next http.RoundTripper) *TracingRoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &TracingRoundTripper{
+		next:   next,
+		config: &config,
+	}
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (rt *TracingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := rt.config.Tracer.Start(req.Context(), "HTTP "+req.Method)
+	span.SetAttributes(
+		Attribute{Key: AttrApp, Value: rt.config.App},
+		Attribute{Key: AttrMethod, Value: req.Method},
+		Attribute{Key: AttrURL, Value: redactURL(req.URL, rt.config.Redact)},
+		Attribute{Key: AttrServer, Value: req.URL.Hostname()},
+		Attribute{Key: AttrSentBytes, Value: sentBytes(req)},
+	)
+
+	req = req.WithContext(ctx)
+
+	if rt.config.Inject != nil {
+		req.Header = req.Header.Clone()
+		rt.config.Inject(ctx, req.Header)
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(StatusError, err.Error())
+		span.End()
+
+		if rt.config.Caller != nil {
+			rt.config.Caller("000 Failed", req.Method, sentBytes(req), 0, err)
+		}
+
+		return resp, err //nolint:wrapcheck
+	}
+
+	span.SetAttributes(Attribute{Key: AttrStatusCode, Value: resp.StatusCode})
+
+	// Client spans are errors for 4xx and 5xx responses, and OK for 2xx responses.
+	switch {
+	case resp.StatusCode >= http.StatusBadRequest:
+		span.SetStatus(StatusError, strconv.Itoa(resp.StatusCode)+" "+http.StatusText(resp.StatusCode))
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		span.SetStatus(StatusOK, "")
+	}
+
+	resp.Body = &countingBody{ReadCloser: resp.Body, onClose: func(rcvd int) {
+		span.SetAttributes(Attribute{Key: AttrRcvdBytes, Value: rcvd})
+		span.End()
+
+		if rt.config.Caller != nil {
+			rt.config.Caller(resp.Status, req.Method, sentBytes(req), rcvd, nil)
+		}
+	}}
+
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package starr
+
+import "time"
+
+/* These are the typed versions of the data in a history record.
+ * The apps send all of the data values as strings, and which ones are
+ * included depends on the record's event type. Get these from the
+ * HistoryRecord methods in each app's module.
+ */
+
+// GrabbedData is the data in a history record when a release was sent to a download client.
+type GrabbedData struct {
+	Indexer            string
+	ReleaseGroup       string
+	Size               int64
+	DownloadClient     string
+	DownloadClientName string
+	DownloadURL        string
+	GUID               string
+	Protocol           string
+	PublishedDate      time.Time
+	Age                int64 // Days since the release was published.
+	NzbInfoURL         string
+	TorrentInfoHash    string
+}
+
+// ImportedData is the data in a history record when a download was imported.
+type ImportedData struct {
+	DroppedPath        string // Where the download client put the file.
+	ImportedPath       string // Where the app moved the file to.
+	DownloadClient     string
+	DownloadClientName string
+}
+
+// FailedData is the data in a history record when a download failed.
+type FailedData struct {
+	Message            string
+	Indexer            string
+	ReleaseGroup       string
+	Size               int64
+	DownloadClient     string
+	DownloadClientName string
+	Protocol           string
+}
+
+// DeletedData is the data in a history record when a file was deleted.
+type DeletedData struct {
+	Reason string // Manual, MissingFromDisk or Upgrade.
+}
+
+// RenamedData is the data in a history record when a file was renamed.
+type RenamedData struct {
+	SourcePath         string
+	SourceRelativePath string
+	Path               string
+	RelativePath       string
+}
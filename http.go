@@ -11,6 +11,7 @@ import (
 	"path"
 	"reflect"
 	"strings"
+	"sync/atomic"
 )
 
 // API is the beginning of every API path.
@@ -32,9 +33,27 @@ type ReqError struct {
 	Msg  string
 	Name string
 	Err  error // sub error, often nil, or not useful.
+	// Failures contains every validation failure the app returned, if it returned any.
+	// Name and Msg are copied from the first failure.
+	Failures ValidationErrors
 	http.Header
 }
 
+// ValidationFailure is returned by Starr apps when an input fails validation.
+// This happens when adding, updating or testing items with invalid data.
+type ValidationFailure struct {
+	PropertyName        string      `json:"propertyName"`
+	ErrorMessage        string      `json:"errorMessage"`
+	AttemptedValue      interface{} `json:"attemptedValue,omitempty"`
+	Severity            string      `json:"severity"`
+	IsWarning           bool        `json:"isWarning"`
+	InfoLink            string      `json:"infoLink,omitempty"`
+	DetailedDescription string      `json:"detailedDescription,omitempty"`
+}
+
+// ValidationErrors is a list of validation failures. Find these in ReqError.Failures.
+type ValidationErrors []*ValidationFailure
+
 // String turns a request into a string. Usually used in error messages.
 func (r *Request) String() string {
 	return r.URI
@@ -58,6 +77,15 @@ func (c *Config) req(ctx context.Context, method string, req Request) (*http.Res
 		return nil, ErrNilClient
 	}
 
+	if c.canLogin(req) {
+		return c.reqWithLogin(ctx, method, req)
+	}
+
+	return c.do(ctx, method, req)
+}
+
+// do makes a single request.
+func (c *Config) do(ctx context.Context, method string, req Request) (*http.Response, error) {
 	httpReq, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.URL, "/")+req.URI, req.Body)
 	if err != nil {
 		return nil, fmt.Errorf("http.NewRequestWithContext(%s): %w", req.URI, err)
@@ -99,23 +127,23 @@ func parseNon200(resp *http.Response) *ReqError {
 		return response
 	}
 
-	type propError struct {
-		Msg  string `json:"errorMessage"`
-		Name string `json:"propertyName"`
-	}
+	var errMsg ValidationFailure
 
-	var errMsg propError
+	if response.Err = json.Unmarshal(response.Body, &errMsg); response.Err == nil && errMsg.ErrorMessage != "" {
+		response.Name, response.Msg = errMsg.PropertyName, errMsg.ErrorMessage
+		response.Failures = ValidationErrors{&errMsg}
 
-	if response.Err = json.Unmarshal(response.Body, &errMsg); response.Err == nil && errMsg.Msg != "" {
-		response.Name, response.Msg = errMsg.Name, errMsg.Msg
 		return response
 	}
 
-	// Sometimes we get a list of errors. This grabs the first one.
-	var errMsg2 []propError
+	// Sometimes we get a list of errors. Name and Msg come from the first one.
+	var errMsg2 ValidationErrors
+
+	if response.Err = json.Unmarshal(response.Body, &errMsg2); response.Err == nil && len(errMsg2) > 0 &&
+		errMsg2[0] != nil && errMsg2[0].ErrorMessage != "" {
+		response.Name, response.Msg = errMsg2[0].PropertyName, errMsg2[0].ErrorMessage
+		response.Failures = errMsg2
 
-	if response.Err = json.Unmarshal(response.Body, &errMsg2); response.Err == nil && len(errMsg2) > 0 {
-		response.Name, response.Msg = errMsg2[0].Name, errMsg2[0].Msg
 		return response
 	}
 
@@ -135,6 +163,10 @@ func (c *Config) SetHeaders(req *http.Request) {
 	// This app allows http auth, in addition to api key (nginx proxy).
 	if auth := c.HTTPUser + ":" + c.HTTPPass; auth != ":" {
 		req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
+	} else if atomic.LoadInt32(&c.basic) == 1 {
+		// The app uses Basic authentication, and asked for it.
+		auth = c.Username + ":" + c.Password
+		req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
 	}
 
 	if req.Body != nil {
@@ -187,6 +219,25 @@ func (r *ReqError) Error() string {
 	}
 }
 
+// Error returns every validation failure joined into one string. Empty (nil) failures are skipped.
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v))
+
+	for _, failure := range v {
+		if failure == nil {
+			continue
+		}
+
+		if failure.PropertyName != "" {
+			msgs = append(msgs, failure.PropertyName+": "+failure.ErrorMessage)
+		} else {
+			msgs = append(msgs, failure.ErrorMessage)
+		}
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
 // Is provides a custom error match facility.
 func (r *ReqError) Is(tgt error) bool {
 	target, ok := tgt.(*ReqError)
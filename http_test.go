@@ -1,6 +1,7 @@
 package starr_test
 
 import (
+	"context"
 	"net/http"
 	"path"
 	"testing"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golift.io/starr"
+	"golift.io/starr/starrtest"
 )
 
 func TestSetAPIPath(t *testing.T) {
@@ -52,3 +54,37 @@ func TestReqError(t *testing.T) {
 	err.Name = "Varname"
 	assert.Equal(t, "invalid status code, 403 >= 300, Varname: Some message", err.Error())
 }
+
+func TestReqErrorFailures(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   "/api/v3/indexer",
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusBadRequest,
+		ResponseBody: `[{"propertyName":"BaseUrl","errorMessage":"Invalid URL","severity":"error"},` +
+			`{"propertyName":"ApiKey","errorMessage":"Invalid API Key","severity":"error"}]`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	config := starr.New("mockAPIkey", mockServer.URL, 0)
+
+	var reqErr *starr.ReqError
+
+	err := config.GetInto(context.Background(), starr.Request{URI: "/v3/indexer"}, &struct{}{})
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, "BaseUrl", reqErr.Name, "name must come from the first failure")
+	assert.Equal(t, "Invalid URL", reqErr.Msg, "message must come from the first failure")
+	assert.Equal(t, starr.ValidationErrors{
+		{PropertyName: "BaseUrl", ErrorMessage: "Invalid URL", Severity: "error"},
+		{PropertyName: "ApiKey", ErrorMessage: "Invalid API Key", Severity: "error"},
+	}, reqErr.Failures)
+	assert.Equal(t, "BaseUrl: Invalid URL; ApiKey: Invalid API Key", reqErr.Failures.Error())
+}
+
+func TestValidationErrorsNil(t *testing.T) {
+	t.Parallel()
+
+	failures := starr.ValidationErrors{nil, {PropertyName: "ApiKey", ErrorMessage: "Invalid API Key"}, nil}
+	assert.Equal(t, "ApiKey: Invalid API Key", failures.Error())
+}
@@ -9,11 +9,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"net/http/cookiejar"
 	"net/url"
 	"strings"
-
-	"golang.org/x/net/publicsuffix"
 )
 
 // APIer is used by the sub packages to allow mocking the http methods in tests.
@@ -55,19 +52,18 @@ type InitializeJS struct {
 }
 
 // Login POSTs to the login form in a Starr app and saves the authentication cookie for future use.
+// You do not need to call this before using non-API paths. Requests to those paths log in
+// automatically when a Username is set, and again when the session expires.
 func (c *Config) Login(ctx context.Context) error {
-	if c.Client.Jar == nil {
-		jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
-		if err != nil {
-			return fmt.Errorf("cookiejar.New(publicsuffix): %w", err)
-		}
-
-		c.Client.Jar = jar
+	jar, err := c.cookieJar()
+	if err != nil {
+		return err
 	}
 
 	params := make(url.Values)
 	params.Add("username", c.Username)
 	params.Add("password", c.Password)
+	params.Add("rememberMe", "on")
 
 	req := Request{URI: "/login", Body: bytes.NewBufferString(params.Encode())}
 	codeErr := &ReqError{}
@@ -85,11 +81,12 @@ func (c *Config) Login(ctx context.Context) error {
 	closeResp(resp)
 
 	if u, _ := url.Parse(c.URL); strings.Contains(codeErr.Get("location"), "loginFailed") ||
-		len(c.Client.Jar.Cookies(u)) == 0 {
+		len(jar.Cookies(u)) == 0 {
 		return fmt.Errorf("%w: authenticating as user '%s' failed", ErrRequestError, c.Username)
 	}
 
-	c.cookie = true
+	// The jar only returns cookie names and values, so keep the attributes for SaveCookies.
+	c.session.Store(loginCookies(codeErr.Header))
 
 	return nil
 }
@@ -101,22 +98,30 @@ func (c *Config) Get(ctx context.Context, req Request) (*http.Response, error) {
 
 // Post makes a POST http request and returns the body.
 func (c *Config) Post(ctx context.Context, req Request) (*http.Response, error) {
+	defer c.invalidate(req)
 	return c.Req(ctx, http.MethodPost, req)
 }
 
 // Put makes a PUT http request and returns the body.
 func (c *Config) Put(ctx context.Context, req Request) (*http.Response, error) {
+	defer c.invalidate(req)
 	return c.Req(ctx, http.MethodPut, req)
 }
 
 // Delete makes a DELETE http request and returns the body.
 func (c *Config) Delete(ctx context.Context, req Request) (*http.Response, error) {
+	defer c.invalidate(req)
 	return c.Req(ctx, http.MethodDelete, req)
 }
 
 // GetInto performs an HTTP GET against an API path and
 // unmarshals the payload into the provided pointer interface.
+// The response is cached if Config.Cache is set.
 func (c *Config) GetInto(ctx context.Context, req Request, output interface{}) error {
+	if c.Cache != nil {
+		return c.cachedGetInto(ctx, req, output)
+	}
+
 	resp, err := c.api(ctx, http.MethodGet, req)
 	return decode(output, resp, err)
 }
@@ -124,6 +129,8 @@ func (c *Config) GetInto(ctx context.Context, req Request, output interface{}) e
 // PostInto performs an HTTP POST against an API path and
 // unmarshals the payload into the provided pointer interface.
 func (c *Config) PostInto(ctx context.Context, req Request, output interface{}) error {
+	defer c.invalidate(req)
+
 	resp, err := c.api(ctx, http.MethodPost, req)
 	return decode(output, resp, err)
 }
@@ -131,12 +138,16 @@ func (c *Config) PostInto(ctx context.Context, req Request, output interface{})
 // PutInto performs an HTTP PUT against an API path and
 // unmarshals the payload into the provided pointer interface.
 func (c *Config) PutInto(ctx context.Context, req Request, output interface{}) error {
+	defer c.invalidate(req)
+
 	resp, err := c.api(ctx, http.MethodPut, req)
 	return decode(output, resp, err)
 }
 
 // DeleteAny performs an HTTP DELETE against an API path, output is ignored.
 func (c *Config) DeleteAny(ctx context.Context, req Request) error {
+	defer c.invalidate(req)
+
 	resp, err := c.api(ctx, http.MethodDelete, req)
 	closeResp(resp)
 
@@ -162,24 +173,14 @@ func decode(output interface{}, resp *http.Response, err error) error {
 }
 
 // GetInitializeJS returns the data from the initialize.js file.
-// If the instance requires authentication, you must call Login() before this method.
+// If the instance requires authentication, set a Username and Password in the Config.
 func (c *Config) GetInitializeJS(ctx context.Context) (*InitializeJS, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL+"initialize.js", nil)
+	resp, err := c.req(ctx, http.MethodGet, Request{URI: "/initialize.js"})
 	if err != nil {
-		return nil, fmt.Errorf("http.NewRequestWithContext(initialize.js): %w", err)
-	}
-
-	resp, err := c.Client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("httpClient.Do(req): %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		_, _ = io.Copy(io.Discard, resp.Body)
-		return nil, &ReqError{Code: resp.StatusCode}
-	}
-
 	return readInitializeJS(resp.Body)
 }
 
@@ -21,6 +21,7 @@ type CommandRequest struct {
 	AlbumID  int64    `json:"albumId,omitempty"`
 	Folders  []string `json:"folders,omitempty"`
 	ArtistID int64    `json:"artistId,omitempty"`
+	Files    []int64  `json:"files,omitempty"` // RenameFiles and RetagFiles only
 }
 
 // CommandResponse comes from the /api/v1/command endpoint.
@@ -0,0 +1,166 @@
+package lidarr_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestHistoryRecordData(t *testing.T) {
+	t.Parallel()
+
+	var records []*lidarr.HistoryRecord
+
+	err := json.Unmarshal([]byte(`[
+		{"eventType": "grabbed", "data": {"indexer": "Indexer", "size": "1024", "age": "3", "protocol": "torrent",
+		 "downloadClient": "qBittorrent", "downloadClientName": "qBit", "torrentInfoHash": "ABC"}},
+		{"eventType": "artistFolderImported", "data": {"droppedPath": "/downloads/a.flac", "importedPath": "/music/a.flac"}},
+		{"eventType": "trackFileImported", "data": {"droppedPath": "/downloads/b.flac", "importedPath": "/music/b.flac",
+		 "downloadClient": "qBittorrent", "downloadClientName": "qBit"}},
+		{"eventType": "trackFileDeleted", "data": {"reason": "Upgrade"}},
+		{"eventType": "trackFileRenamed", "data": {"sourcePath": "/music/c.flac", "path": "/music/d.flac"}},
+		{"eventType": "trackFileRetagged", "data": {}},
+		{"eventType": "somethingNew", "data": {}}
+	]`), &records)
+	require.NoError(t, err)
+
+	assert.Equal(t, lidarr.FilterGrabbed, records[0].Filter())
+	assert.Equal(t, &starr.GrabbedData{
+		Indexer:            "Indexer",
+		Size:               1024,
+		Age:                3,
+		Protocol:           "torrent",
+		DownloadClient:     "qBittorrent",
+		DownloadClientName: "qBit",
+		TorrentInfoHash:    "ABC",
+	}, records[0].Grabbed())
+	assert.Nil(t, records[0].Imported())
+
+	assert.Equal(t, lidarr.FilterArtistFolderImported, records[1].Filter())
+	assert.Equal(t, &starr.ImportedData{DroppedPath: "/downloads/a.flac", ImportedPath: "/music/a.flac"}, records[1].Imported())
+
+	assert.Equal(t, lidarr.FilterTrackFileImported, records[2].Filter())
+	assert.Equal(t, &starr.ImportedData{
+		DroppedPath:        "/downloads/b.flac",
+		ImportedPath:       "/music/b.flac",
+		DownloadClient:     "qBittorrent",
+		DownloadClientName: "qBit",
+	}, records[2].Imported())
+
+	assert.Equal(t, lidarr.FilterDeleted, records[3].Filter())
+	assert.Equal(t, &starr.DeletedData{Reason: "Upgrade"}, records[3].Deleted())
+	assert.Nil(t, records[3].Renamed())
+
+	assert.Equal(t, lidarr.FilterRenamed, records[4].Filter())
+	assert.Equal(t, &starr.RenamedData{SourcePath: "/music/c.flac", Path: "/music/d.flac"}, records[4].Renamed())
+
+	assert.Equal(t, lidarr.FilterRetagged, records[5].Filter())
+	assert.Nil(t, records[5].Imported())
+	assert.Nil(t, records[5].Deleted())
+
+	assert.Equal(t, lidarr.FilterUnknown, records[6].Filter())
+	assert.Nil(t, records[6].Grabbed())
+	assert.Nil(t, records[6].Failed())
+}
+
+func TestGetArtistHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "history/artist?artistId=7&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "artistId": 7, "albumId": 2, "eventType": "trackFileImported"}]`,
+			WithResponse:   []*lidarr.HistoryRecord{{ID: 1, ArtistID: 7, AlbumID: 2, EventType: "trackFileImported"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "history/artist?artistId=7&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*lidarr.HistoryRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetArtistHistory(7, lidarr.FilterTrackFileImported)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetHistorySince(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("test", -3600))
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "history/since?date=2023-01-02T04%3A04%3A05Z&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "artistId": 7, "albumId": 2, "eventType": "trackFileImported"}]`,
+			WithResponse:   []*lidarr.HistoryRecord{{ID: 1, ArtistID: 7, AlbumID: 2, EventType: "trackFileImported"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "history/since?date=2023-01-02T04%3A04%3A05Z&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*lidarr.HistoryRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHistorySince(date, lidarr.FilterTrackFileImported)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetArtistHistoryAllEvents(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		Name:           "200",
+		ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "history/artist?artistId=7"),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `[]`,
+		WithResponse:   []*lidarr.HistoryRecord{},
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetArtistHistory(7, lidarr.FilterUnknown)
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+}
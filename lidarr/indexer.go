@@ -168,3 +168,127 @@ func (l *Lidarr) DeleteIndexerContext(ctx context.Context, indexerID int64) erro
 
 	return nil
 }
+
+// GetIndexerSchema returns every indexer implementation, and the fields each one accepts.
+// Use IndexerInputFromSchema to turn an entry into a new IndexerInput.
+func (l *Lidarr) GetIndexerSchema() ([]*IndexerOutput, error) {
+	return l.GetIndexerSchemaContext(context.Background())
+}
+
+// GetIndexerSchemaContext returns every indexer implementation, and the fields each one accepts.
+func (l *Lidarr) GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error) {
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "schema")}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// IndexerInputFromSchema builds an IndexerInput from a schema entry and a map of field names to values.
+// Fields that are not in the map use the schema default. See starr.FieldsFromSchema for validation details.
+// The returned input is not nil when an error is returned, so it may still be inspected.
+func IndexerInputFromSchema(schema *IndexerOutput, values map[string]interface{}) (*IndexerInput, error) {
+	fields, err := starr.FieldsFromSchema(schema.Fields, values)
+	input := &IndexerInput{
+		EnableAutomaticSearch:   schema.EnableAutomaticSearch,
+		EnableInteractiveSearch: schema.EnableInteractiveSearch,
+		EnableRss:               schema.EnableRss,
+		Priority:                schema.Priority,
+		ConfigContract:          schema.ConfigContract,
+		Implementation:          schema.Implementation,
+		Name:                    schema.Name,
+		Protocol:                schema.Protocol,
+		Tags:                    schema.Tags,
+		Fields:                  fields,
+	}
+
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", schema.Implementation, err)
+	}
+
+	return input, nil
+}
+
+// BulkIndexer is the input for the bulk indexer editor, UpdateBulkIndexers.
+// Set IDs to the indexers you want to change, and set only the members you want to update.
+// You may use starr.True(), starr.False() and starr.Int64() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for ApplyTags.
+type BulkIndexer struct {
+	IDs                     []int64          `json:"ids"`
+	Tags                    []int            `json:"tags,omitempty"`
+	ApplyTags               *starr.ApplyTags `json:"applyTags,omitempty"`
+	EnableRss               *bool            `json:"enableRss,omitempty"`
+	EnableAutomaticSearch   *bool            `json:"enableAutomaticSearch,omitempty"`
+	EnableInteractiveSearch *bool            `json:"enableInteractiveSearch,omitempty"`
+	Priority                *int64           `json:"priority,omitempty"`
+}
+
+// UpdateBulkIndexers updates many indexers at once. Only the non-nil members of the input are changed.
+func (l *Lidarr) UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error) {
+	return l.UpdateBulkIndexersContext(context.Background(), bulk)
+}
+
+// UpdateBulkIndexersContext updates many indexers at once. Only the non-nil members of the input are changed.
+func (l *Lidarr) UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBulkIndexers removes many indexers at once.
+func (l *Lidarr) DeleteBulkIndexers(ids []int64) error {
+	return l.DeleteBulkIndexersContext(context.Background(), ids)
+}
+
+// DeleteBulkIndexersContext removes many indexers at once.
+func (l *Lidarr) DeleteBulkIndexersContext(ctx context.Context, ids []int64) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllIndexers tests every configured indexer, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (l *Lidarr) TestAllIndexers() ([]*starr.ProviderTestResult, error) {
+	return l.TestAllIndexersContext(context.Background())
+}
+
+// TestAllIndexersContext tests every configured indexer, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (l *Lidarr) TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var output []*starr.ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpIndexer, "testall")}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		if output, err = starr.ProviderTestResults(err); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	}
+
+	return output, nil
+}
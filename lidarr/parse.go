@@ -0,0 +1,61 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpParse = APIver + "/parse"
+
+// ParsedAlbumInfo is the information parsed from an album release title.
+type ParsedAlbumInfo struct {
+	ReleaseTitle     string           `json:"releaseTitle"`
+	AlbumTitle       string           `json:"albumTitle"`
+	ArtistName       string           `json:"artistName"`
+	AlbumType        string           `json:"albumType"`
+	ArtistTitleInfo  *ArtistTitleInfo `json:"artistTitleInfo"`
+	Quality          *starr.Quality   `json:"quality"`
+	ReleaseDate      string           `json:"releaseDate"`
+	Discography      bool             `json:"discography"`
+	DiscographyStart int              `json:"discographyStart"`
+	DiscographyEnd   int              `json:"discographyEnd"`
+	ReleaseGroup     string           `json:"releaseGroup"`
+	ReleaseHash      string           `json:"releaseHash"`
+	ReleaseVersion   string           `json:"releaseVersion"`
+}
+
+// ParseOutput is what you get from the parse endpoint when you provide a parsable title.
+type ParseOutput struct {
+	ID                int64                 `json:"id"`
+	Title             string                `json:"title"`
+	Artist            *Artist               `json:"artist"`
+	Albums            []*Album              `json:"albums"`
+	CustomFormats     []*CustomFormatOutput `json:"customFormats"`
+	CustomFormatScore int64                 `json:"customFormatScore"`
+	// You need to check this for nil before accessing it.
+	// If the parse failed, this won't exist, and you won't get an error.
+	ParsedAlbumInfo *ParsedAlbumInfo `json:"parsedAlbumInfo"`
+}
+
+// Parse a release title into album info.
+// Artist and Albums are only included if the title matched an artist in Lidarr.
+func (l *Lidarr) Parse(title string) (*ParseOutput, error) {
+	return l.ParseContext(context.Background(), title)
+}
+
+// ParseContext parses a release title into album info.
+func (l *Lidarr) ParseContext(ctx context.Context, title string) (*ParseOutput, error) {
+	var output *ParseOutput
+
+	req := starr.Request{URI: bpParse, Query: make(url.Values)}
+	req.Query.Set("title", title)
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,96 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+const parseBody = `{
+	"title": "Artist Name - Album Title (2020) [FLAC]",
+	"parsedAlbumInfo": {
+		"releaseTitle": "Artist Name - Album Title (2020) [FLAC]",
+		"albumTitle": "Album Title",
+		"artistName": "Artist Name",
+		"artistTitleInfo": {"title": "Artist Name", "titleWithoutYear": "Artist Name", "year": 0},
+		"quality": {
+			"quality": {"id": 6, "name": "FLAC"},
+			"revision": {"version": 1, "real": 0, "isRepack": false}
+		},
+		"releaseDate": "2020",
+		"discography": false,
+		"discographyStart": 0,
+		"discographyEnd": 0,
+		"releaseVersion": ""
+	},
+	"artist": {"id": 2, "artistName": "Artist Name"},
+	"albums": [{"id": 5, "title": "Album Title", "artistId": 2}],
+	"customFormats": [],
+	"customFormatScore": 0
+}`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	const title = "Artist Name - Album Title (2020) [FLAC]"
+
+	expectedPath := path.Join("/", starr.API, lidarr.APIver,
+		"parse?title=Artist+Name+-+Album+Title+%282020%29+%5BFLAC%5D")
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   expectedPath,
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   parseBody,
+			WithResponse: &lidarr.ParseOutput{
+				Title: title,
+				ParsedAlbumInfo: &lidarr.ParsedAlbumInfo{
+					ReleaseTitle: title,
+					AlbumTitle:   "Album Title",
+					ArtistName:   "Artist Name",
+					ArtistTitleInfo: &lidarr.ArtistTitleInfo{
+						Title:            "Artist Name",
+						TitleWithoutYear: "Artist Name",
+					},
+					Quality: &starr.Quality{
+						Quality:  &starr.BaseQuality{ID: 6, Name: "FLAC"},
+						Revision: &starr.QualityRevision{Version: 1},
+					},
+					ReleaseDate: "2020",
+				},
+				Artist:        &lidarr.Artist{ID: 2, ArtistName: "Artist Name"},
+				Albums:        []*lidarr.Album{{ID: 5, Title: "Album Title", ArtistID: 2}},
+				CustomFormats: []*lidarr.CustomFormatOutput{},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   expectedPath,
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*lidarr.ParseOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Parse(title)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
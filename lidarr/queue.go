@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
@@ -109,6 +110,54 @@ func (l *Lidarr) GetQueuePageContext(ctx context.Context, params *starr.PageReq)
 	return &output, nil
 }
 
+// GetQueueDetails returns every record in the Activity Queue without paging.
+// Provide a artist ID to return only the records for that artist, and album IDs to narrow it
+// down further. A zero artist ID returns all of them, including items that are not matched to one.
+func (l *Lidarr) GetQueueDetails(artistID int64, albumIDs ...int64) ([]*QueueRecord, error) {
+	return l.GetQueueDetailsContext(context.Background(), artistID, albumIDs...)
+}
+
+// GetQueueDetailsContext returns every record in the Activity Queue without paging.
+func (l *Lidarr) GetQueueDetailsContext(ctx context.Context,
+	artistID int64, albumIDs ...int64,
+) ([]*QueueRecord, error) {
+	var output []*QueueRecord
+
+	req := starr.Request{URI: path.Join(bpQueue, "details"), Query: make(url.Values)}
+	req.Query.Set("includeUnknownArtistItems", "true")
+
+	if artistID > 0 {
+		req.Query.Set("artistId", starr.Itoa(artistID))
+	}
+
+	for _, id := range albumIDs {
+		req.Query.Add("albumIds", starr.Itoa(id))
+	}
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetQueueStatus returns the number of records in the Activity Queue, and if any of them have errors or warnings.
+func (l *Lidarr) GetQueueStatus() (*starr.QueueStatus, error) {
+	return l.GetQueueStatusContext(context.Background())
+}
+
+// GetQueueStatusContext returns the number of records in the Activity Queue, and if any have errors or warnings.
+func (l *Lidarr) GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error) {
+	var output starr.QueueStatus
+
+	req := starr.Request{URI: path.Join(bpQueue, "status")}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
 // DeleteQueue deletes an item from the Activity Queue.
 func (l *Lidarr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
 	return l.DeleteQueueContext(context.Background(), queueID, opts)
@@ -124,6 +173,31 @@ func (l *Lidarr) DeleteQueueContext(ctx context.Context, queueID int64, opts *st
 	return nil
 }
 
+// DeleteBulkQueue deletes many items from the Activity Queue at once.
+// The delete options are applied to every item.
+func (l *Lidarr) DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	return l.DeleteBulkQueueContext(context.Background(), queueIDs, opts)
+}
+
+// DeleteBulkQueueContext deletes many items from the Activity Queue at once.
+func (l *Lidarr) DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: queueIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpQueue, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpQueue, "bulk"), Query: opts.Values(), Body: &body}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
 // QueueGrab tells the app to grab an item that's in queue.
 // Most often used on items with a delay set from a delay profile.
 func (l *Lidarr) QueueGrab(ids ...int64) error {
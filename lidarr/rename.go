@@ -0,0 +1,94 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const (
+	bpRename = APIver + "/rename"
+	bpRetag  = APIver + "/retag"
+)
+
+// Rename is the /api/v1/rename endpoint.
+// This is a preview of the changes the RenameFiles command will make.
+type Rename struct {
+	ArtistID     int64  `json:"artistId"`
+	AlbumID      int64  `json:"albumId"`
+	TrackNumbers []int  `json:"trackNumbers"`
+	TrackFileID  int64  `json:"trackFileId"`
+	ExistingPath string `json:"existingPath"`
+	NewPath      string `json:"newPath"`
+}
+
+// Retag is the /api/v1/retag endpoint.
+// This is a preview of the changes the RetagFiles command will make.
+type Retag struct {
+	ArtistID     int64          `json:"artistId"`
+	AlbumID      int64          `json:"albumId"`
+	TrackNumbers []int          `json:"trackNumbers"`
+	TrackFileID  int64          `json:"trackFileId"`
+	Path         string         `json:"path"`
+	Changes      []*RetagChange `json:"changes"`
+}
+
+// RetagChange is part of Retag, and describes a single tag change.
+type RetagChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// GetRenames returns the files that would be renamed for an artist.
+// Set albumID to 0 to preview every album.
+// Pass the TrackFileID values to SendCommand with a RenameFiles command to apply the changes.
+func (l *Lidarr) GetRenames(artistID, albumID int64) ([]*Rename, error) {
+	return l.GetRenamesContext(context.Background(), artistID, albumID)
+}
+
+// GetRenamesContext returns the files that would be renamed for an artist.
+// Set albumID to 0 to preview every album.
+func (l *Lidarr) GetRenamesContext(ctx context.Context, artistID, albumID int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: renameParams(artistID, albumID)}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetRetags returns the files that would have their tags updated for an artist.
+// Set albumID to 0 to preview every album.
+// Pass the TrackFileID values to SendCommand with a RetagFiles command to apply the changes.
+func (l *Lidarr) GetRetags(artistID, albumID int64) ([]*Retag, error) {
+	return l.GetRetagsContext(context.Background(), artistID, albumID)
+}
+
+// GetRetagsContext returns the files that would have their tags updated for an artist.
+// Set albumID to 0 to preview every album.
+func (l *Lidarr) GetRetagsContext(ctx context.Context, artistID, albumID int64) ([]*Retag, error) {
+	var output []*Retag
+
+	req := starr.Request{URI: bpRetag, Query: renameParams(artistID, albumID)}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+func renameParams(artistID, albumID int64) url.Values {
+	params := make(url.Values)
+	params.Set("artistId", starr.Itoa(artistID))
+
+	if albumID > 0 {
+		params.Set("albumId", starr.Itoa(albumID))
+	}
+
+	return params
+}
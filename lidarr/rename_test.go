@@ -0,0 +1,104 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetRenames(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rename?albumId=4&artistId=2"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"artistId":2,"albumId":4,"trackNumbers":[1],"trackFileId":8,` +
+				`"existingPath":"01 track.flac","newPath":"01 - Track.flac"}]`,
+			WithRequest: int64(4),
+			WithResponse: []*lidarr.Rename{{
+				ArtistID:     2,
+				AlbumID:      4,
+				TrackNumbers: []int{1},
+				TrackFileID:  8,
+				ExistingPath: "01 track.flac",
+				NewPath:      "01 - Track.flac",
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "rename?artistId=2"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    int64(0),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*lidarr.Rename)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRenames(2, test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetRetags(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "retag?artistId=2"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"artistId":2,"albumId":4,"trackNumbers":[1],"trackFileId":8,"path":"01 - Track.flac",` +
+				`"changes":[{"field":"Title","oldValue":"track","newValue":"Track"}]}]`,
+			WithResponse: []*lidarr.Retag{{
+				ArtistID:     2,
+				AlbumID:      4,
+				TrackNumbers: []int{1},
+				TrackFileID:  8,
+				Path:         "01 - Track.flac",
+				Changes:      []*lidarr.RetagChange{{Field: "Title", OldValue: "track", NewValue: "Track"}},
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "retag?artistId=2"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*lidarr.Retag)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRetags(2, 0)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -9,7 +9,11 @@ import (
 	"golift.io/starr"
 )
 
-const bpSystem = APIver + "/system"
+const (
+	bpSystem    = APIver + "/system"
+	bpDiskSpace = APIver + "/diskspace"
+	bpHealth    = APIver + "/health"
+)
 
 // SystemStatus is the /api/v1/system/status endpoint.
 type SystemStatus struct {
@@ -78,3 +82,37 @@ func (l *Lidarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile
 
 	return output, nil
 }
+
+// GetDiskSpace returns the free and total space for every disk Lidarr uses.
+func (l *Lidarr) GetDiskSpace() ([]*starr.DiskSpace, error) {
+	return l.GetDiskSpaceContext(context.Background())
+}
+
+// GetDiskSpaceContext returns the free and total space for every disk Lidarr uses.
+func (l *Lidarr) GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error) {
+	var output []*starr.DiskSpace
+
+	req := starr.Request{URI: bpDiskSpace}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetHealth returns the health check warnings and errors from Lidarr.
+func (l *Lidarr) GetHealth() ([]*starr.Health, error) {
+	return l.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns the health check warnings and errors from Lidarr.
+func (l *Lidarr) GetHealthContext(ctx context.Context) ([]*starr.Health, error) {
+	var output []*starr.Health
+
+	req := starr.Request{URI: bpHealth}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
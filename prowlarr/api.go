@@ -0,0 +1,127 @@
+package prowlarr
+
+import (
+	"context"
+	"time"
+
+	"golift.io/starr"
+)
+
+/* This file contains interfaces for the Prowlarr methods, grouped by resource.
+ * Depend on one of these, or on API, instead of *Prowlarr to swap in a fake during tests.
+ * The starrtest/mocks package has a ready-made Prowlarr mock that satisfies API.
+ */
+
+// API contains every Prowlarr method, except the methods from the embedded starr.APIer.
+type API interface {
+	DownloadClientAPI
+	IndexerAPI
+	IndexerStatsAPI
+	NotificationAPI
+	SystemAPI
+	TagAPI
+}
+
+// Prowlarr must satisfy the API interface.
+var _ API = (*Prowlarr)(nil)
+
+// DownloadClientAPI contains the Prowlarr methods for download clients.
+type DownloadClientAPI interface {
+	GetDownloadClients() ([]*DownloadClientOutput, error)
+	GetDownloadClientsContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	GetDownloadClient(downloadclientID int64) (*DownloadClientOutput, error)
+	GetDownloadClientContext(ctx context.Context, clientID int64) (*DownloadClientOutput, error)
+	AddDownloadClient(downloadclient *DownloadClientInput) (*DownloadClientOutput, error)
+	AddDownloadClientContext(ctx context.Context, client *DownloadClientInput) (*DownloadClientOutput, error)
+	TestDownloadClient(client *DownloadClientInput) error
+	TestDownloadClientContext(ctx context.Context, client *DownloadClientInput) error
+	UpdateDownloadClient(client *DownloadClientInput, force bool) (*DownloadClientOutput, error)
+	UpdateDownloadClientContext(ctx context.Context,
+		client *DownloadClientInput,
+		force bool,
+	) (*DownloadClientOutput, error)
+	DeleteDownloadClient(downloadclientID int64) error
+	DeleteDownloadClientContext(ctx context.Context, downloadclientID int64) error
+	GetDownloadClientSchema() ([]*DownloadClientOutput, error)
+	GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClients(bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClientsContext(ctx context.Context, bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	DeleteBulkDownloadClients(ids []int64) error
+	DeleteBulkDownloadClientsContext(ctx context.Context, ids []int64) error
+	TestAllDownloadClients() ([]*starr.ProviderTestResult, error)
+	TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// IndexerAPI contains the Prowlarr methods for indexers.
+type IndexerAPI interface {
+	GetIndexers() ([]*IndexerOutput, error)
+	GetIndexersContext(ctx context.Context) ([]*IndexerOutput, error)
+	TestIndexer(indexer *IndexerInput) error
+	TestIndexerContext(ctx context.Context, indexer *IndexerInput) error
+	GetIndexer(indexerID int64) (*IndexerOutput, error)
+	GetIndexerContext(ctx context.Context, indexerID int64) (*IndexerOutput, error)
+	AddIndexer(indexer *IndexerInput) (*IndexerOutput, error)
+	AddIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error)
+	UpdateIndexer(indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	UpdateIndexerContext(ctx context.Context, indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	DeleteIndexer(indexerID int64) error
+	DeleteIndexerContext(ctx context.Context, indexerID int64) error
+	GetIndexerSchema() ([]*IndexerOutput, error)
+	GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error)
+	UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error)
+	UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error)
+	DeleteBulkIndexers(ids []int64) error
+	DeleteBulkIndexersContext(ctx context.Context, ids []int64) error
+	TestAllIndexers() ([]*starr.ProviderTestResult, error)
+	TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// IndexerStatsAPI contains the Prowlarr methods for indexer statistics.
+type IndexerStatsAPI interface {
+	GetIndexerStats(start, end time.Time) (*IndexerStats, error)
+	GetIndexerStatsContext(ctx context.Context, start, end time.Time) (*IndexerStats, error)
+}
+
+// NotificationAPI contains the Prowlarr methods for notifications.
+type NotificationAPI interface {
+	GetNotifications() ([]*NotificationOutput, error)
+	GetNotificationsContext(ctx context.Context) ([]*NotificationOutput, error)
+	GetNotification(notificationID int) (*NotificationOutput, error)
+	GetNotificationContext(ctx context.Context, notificationID int) (*NotificationOutput, error)
+	AddNotification(notification *NotificationInput) (*NotificationOutput, error)
+	AddNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	UpdateNotification(notification *NotificationInput) (*NotificationOutput, error)
+	UpdateNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	DeleteNotification(notificationID int64) error
+	DeleteNotificationContext(ctx context.Context, notificationID int64) error
+	GetNotificationSchema() ([]*NotificationOutput, error)
+	GetNotificationSchemaContext(ctx context.Context) ([]*NotificationOutput, error)
+	TestAllNotifications() ([]*starr.ProviderTestResult, error)
+	TestAllNotificationsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// SystemAPI contains the Prowlarr methods for system status, backups, logs and other system endpoints.
+type SystemAPI interface {
+	Ping() error
+	PingContext(ctx context.Context) error
+	GetSystemStatus() (*SystemStatus, error)
+	GetSystemStatusContext(ctx context.Context) (*SystemStatus, error)
+	GetBackupFiles() ([]*starr.BackupFile, error)
+	GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error)
+	GetHealth() ([]*starr.Health, error)
+	GetHealthContext(ctx context.Context) ([]*starr.Health, error)
+}
+
+// TagAPI contains the Prowlarr methods for tags.
+type TagAPI interface {
+	GetTags() ([]*starr.Tag, error)
+	GetTagsContext(ctx context.Context) ([]*starr.Tag, error)
+	GetTag(tagID int) (*starr.Tag, error)
+	GetTagContext(ctx context.Context, tagID int) (*starr.Tag, error)
+	AddTag(tag *starr.Tag) (*starr.Tag, error)
+	AddTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	UpdateTag(tag *starr.Tag) (*starr.Tag, error)
+	UpdateTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	DeleteTag(tagID int) error
+	DeleteTagContext(ctx context.Context, tagID int) error
+}
@@ -204,3 +204,130 @@ func (p *Prowlarr) DeleteIndexerContext(ctx context.Context, indexerID int64) er
 
 	return nil
 }
+
+// GetIndexerSchema returns every indexer implementation, and the fields each one accepts.
+// Use IndexerInputFromSchema to turn an entry into a new IndexerInput.
+func (p *Prowlarr) GetIndexerSchema() ([]*IndexerOutput, error) {
+	return p.GetIndexerSchemaContext(context.Background())
+}
+
+// GetIndexerSchemaContext returns every indexer implementation, and the fields each one accepts.
+func (p *Prowlarr) GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error) {
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "schema")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// IndexerInputFromSchema builds an IndexerInput from a schema entry and a map of field names to values.
+// Fields that are not in the map use the schema default. See starr.FieldsFromSchema for validation details.
+// The returned input is not nil when an error is returned, so it may still be inspected.
+func IndexerInputFromSchema(schema *IndexerOutput, values map[string]interface{}) (*IndexerInput, error) {
+	fields, err := starr.FieldsFromSchema(schema.Fields, values)
+	input := &IndexerInput{
+		Enable:         schema.Enable,
+		Redirect:       schema.Redirect,
+		Priority:       schema.Priority,
+		AppProfileID:   schema.AppProfileID,
+		ConfigContract: schema.ConfigContract,
+		Implementation: schema.Implementation,
+		Name:           schema.Name,
+		Protocol:       schema.Protocol,
+		Tags:           schema.Tags,
+		Fields:         fields,
+	}
+
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", schema.Implementation, err)
+	}
+
+	return input, nil
+}
+
+// BulkIndexer is the input for the bulk indexer editor, UpdateBulkIndexers.
+// Set IDs to the indexers you want to change, and set only the members you want to update.
+// You may use starr.True(), starr.False() and starr.Int64() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for ApplyTags.
+type BulkIndexer struct {
+	IDs            []int64          `json:"ids"`
+	Tags           []int            `json:"tags,omitempty"`
+	ApplyTags      *starr.ApplyTags `json:"applyTags,omitempty"`
+	Enable         *bool            `json:"enable,omitempty"`
+	AppProfileID   *int64           `json:"appProfileId,omitempty"`
+	Priority       *int64           `json:"priority,omitempty"`
+	MinimumSeeders *int64           `json:"minimumSeeders,omitempty"`
+	SeedRatio      *float64         `json:"seedRatio,omitempty"`
+	SeedTime       *int64           `json:"seedTime,omitempty"`
+	PackSeedTime   *int64           `json:"packSeedTime,omitempty"`
+}
+
+// UpdateBulkIndexers updates many indexers at once. Only the non-nil members of the input are changed.
+func (p *Prowlarr) UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error) {
+	return p.UpdateBulkIndexersContext(context.Background(), bulk)
+}
+
+// UpdateBulkIndexersContext updates many indexers at once. Only the non-nil members of the input are changed.
+func (p *Prowlarr) UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBulkIndexers removes many indexers at once.
+func (p *Prowlarr) DeleteBulkIndexers(ids []int64) error {
+	return p.DeleteBulkIndexersContext(context.Background(), ids)
+}
+
+// DeleteBulkIndexersContext removes many indexers at once.
+func (p *Prowlarr) DeleteBulkIndexersContext(ctx context.Context, ids []int64) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllIndexers tests every configured indexer, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (p *Prowlarr) TestAllIndexers() ([]*starr.ProviderTestResult, error) {
+	return p.TestAllIndexersContext(context.Background())
+}
+
+// TestAllIndexersContext tests every configured indexer, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (p *Prowlarr) TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var output []*starr.ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpIndexer, "testall")}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		if output, err = starr.ProviderTestResults(err); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	}
+
+	return output, nil
+}
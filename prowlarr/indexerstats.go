@@ -0,0 +1,78 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golift.io/starr"
+)
+
+const bpIndexerStats = APIver + "/indexerstats"
+
+// IndexerStats is the /api/v1/indexerstats endpoint.
+type IndexerStats struct {
+	ID         int64                  `json:"id"`
+	Indexers   []*IndexerStatistics   `json:"indexers"`
+	UserAgents []*UserAgentStatistics `json:"userAgents"`
+	Hosts      []*HostStatistics      `json:"hosts"`
+}
+
+// IndexerStatistics is the query and grab statistics for a single indexer.
+type IndexerStatistics struct {
+	IndexerID                 int64  `json:"indexerId"`
+	IndexerName               string `json:"indexerName"`
+	AverageResponseTime       int64  `json:"averageResponseTime"` // milliseconds
+	AverageGrabResponseTime   int64  `json:"averageGrabResponseTime"`
+	NumberOfQueries           int64  `json:"numberOfQueries"`
+	NumberOfGrabs             int64  `json:"numberOfGrabs"`
+	NumberOfRssQueries        int64  `json:"numberOfRssQueries"`
+	NumberOfAuthQueries       int64  `json:"numberOfAuthQueries"`
+	NumberOfFailedQueries     int64  `json:"numberOfFailedQueries"`
+	NumberOfFailedGrabs       int64  `json:"numberOfFailedGrabs"`
+	NumberOfFailedRssQueries  int64  `json:"numberOfFailedRssQueries"`
+	NumberOfFailedAuthQueries int64  `json:"numberOfFailedAuthQueries"`
+}
+
+// UserAgentStatistics is the query and grab statistics for a single user agent.
+type UserAgentStatistics struct {
+	UserAgent       string `json:"userAgent"`
+	NumberOfQueries int64  `json:"numberOfQueries"`
+	NumberOfGrabs   int64  `json:"numberOfGrabs"`
+}
+
+// HostStatistics is the query and grab statistics for a single host.
+type HostStatistics struct {
+	Host            string `json:"host"`
+	NumberOfQueries int64  `json:"numberOfQueries"`
+	NumberOfGrabs   int64  `json:"numberOfGrabs"`
+}
+
+// GetIndexerStats returns the indexer, user agent and host statistics between two dates.
+// Leave start or end at the zero time to not limit the period on that side.
+func (p *Prowlarr) GetIndexerStats(start, end time.Time) (*IndexerStats, error) {
+	return p.GetIndexerStatsContext(context.Background(), start, end)
+}
+
+// GetIndexerStatsContext returns the indexer, user agent and host statistics between two dates.
+// Leave start or end at the zero time to not limit the period on that side.
+func (p *Prowlarr) GetIndexerStatsContext(ctx context.Context, start, end time.Time) (*IndexerStats, error) {
+	var output IndexerStats
+
+	req := starr.Request{URI: bpIndexerStats, Query: make(url.Values)}
+
+	if !start.IsZero() {
+		req.Query.Set("startDate", start.UTC().Format(time.RFC3339))
+	}
+
+	if !end.IsZero() {
+		req.Query.Set("endDate", end.UTC().Format(time.RFC3339))
+	}
+
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -9,7 +9,10 @@ import (
 	"golift.io/starr"
 )
 
-const bpSystem = APIver + "/system"
+const (
+	bpSystem = APIver + "/system"
+	bpHealth = APIver + "/health"
+)
 
 // SystemStatus is the /api/v1/system/status endpoint.
 type SystemStatus struct {
@@ -81,3 +84,20 @@ func (p *Prowlarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFi
 
 	return output, nil
 }
+
+// GetHealth returns the health check warnings and errors from Prowlarr.
+func (p *Prowlarr) GetHealth() ([]*starr.Health, error) {
+	return p.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns the health check warnings and errors from Prowlarr.
+func (p *Prowlarr) GetHealthContext(ctx context.Context) ([]*starr.Health, error) {
+	var output []*starr.Health
+
+	req := starr.Request{URI: bpHealth}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,460 @@
+package radarr
+
+import (
+	"context"
+	"time"
+
+	"golift.io/starr"
+)
+
+/* This file contains interfaces for the Radarr methods, grouped by resource.
+ * Depend on one of these, or on API, instead of *Radarr to swap in a fake during tests.
+ * The starrtest/mocks package has a ready-made Radarr mock that satisfies API.
+ */
+
+// API contains every Radarr method, except the methods from the embedded starr.APIer.
+type API interface {
+	BlocklistAPI
+	CalendarAPI
+	CommandAPI
+	CustomFormatAPI
+	DelayProfileAPI
+	DownloadClientAPI
+	DownloadClientConfigAPI
+	ExclusionAPI
+	HistoryAPI
+	ImportListAPI
+	ImportListMovieAPI
+	IndexerAPI
+	IndexerConfigAPI
+	ManualImportAPI
+	MediaManagementAPI
+	MovieAPI
+	MovieEditorAPI
+	MovieFileAPI
+	NamingAPI
+	NotificationAPI
+	ParseAPI
+	QualityDefinitionAPI
+	QualityProfileAPI
+	QueueAPI
+	ReleaseProfileAPI
+	RemotePathMappingAPI
+	RenameAPI
+	RestrictionAPI
+	RootFolderAPI
+	SystemAPI
+	TagAPI
+}
+
+// Radarr must satisfy the API interface.
+var _ API = (*Radarr)(nil)
+
+// BlocklistAPI contains the Radarr methods for the blocklist.
+type BlocklistAPI interface {
+	GetBlockList(count int) (*BlockList, error)
+	GetBlockListContext(ctx context.Context, records int) (*BlockList, error)
+	GetBlockListPage(params *starr.PageReq) (*BlockList, error)
+	GetBlockListPageContext(ctx context.Context, params *starr.PageReq) (*BlockList, error)
+	DeleteBlockList(listID int64) error
+	DeleteBlockListContext(ctx context.Context, listID int64) error
+	DeleteBlockLists(ids []int64) error
+	DeleteBlockListsContext(ctx context.Context, ids []int64) error
+}
+
+// CalendarAPI contains the Radarr methods for the calendar.
+type CalendarAPI interface {
+	GetCalendar(filter Calendar) ([]*Movie, error)
+	GetCalendarContext(ctx context.Context, filter Calendar) ([]*Movie, error)
+	GetCalendarID(calendarID int64) (*Movie, error)
+	GetCalendarIDContext(ctx context.Context, calendarID int64) (*Movie, error)
+}
+
+// CommandAPI contains the Radarr methods for commands.
+type CommandAPI interface {
+	GetCommands() ([]*CommandResponse, error)
+	GetCommandsContext(ctx context.Context) ([]*CommandResponse, error)
+	SendCommand(cmd *CommandRequest) (*CommandResponse, error)
+	SendCommandContext(ctx context.Context, cmd *CommandRequest) (*CommandResponse, error)
+}
+
+// CustomFormatAPI contains the Radarr methods for custom formats.
+type CustomFormatAPI interface {
+	GetCustomFormats() ([]*CustomFormatOutput, error)
+	GetCustomFormatsContext(ctx context.Context) ([]*CustomFormatOutput, error)
+	GetCustomFormat(customformatID int64) (*CustomFormatOutput, error)
+	GetCustomFormatContext(ctx context.Context, customformatID int64) (*CustomFormatOutput, error)
+	AddCustomFormat(format *CustomFormatInput) (*CustomFormatOutput, error)
+	AddCustomFormatContext(ctx context.Context, format *CustomFormatInput) (*CustomFormatOutput, error)
+	UpdateCustomFormat(cf *CustomFormatInput) (*CustomFormatOutput, error)
+	UpdateCustomFormatContext(ctx context.Context, format *CustomFormatInput) (*CustomFormatOutput, error)
+	DeleteCustomFormat(cfID int64) error
+	DeleteCustomFormatContext(ctx context.Context, cfID int64) error
+}
+
+// DelayProfileAPI contains the Radarr methods for delay profiles.
+type DelayProfileAPI interface {
+	GetDelayProfiles() ([]*DelayProfile, error)
+	GetDelayProfilesContext(ctx context.Context) ([]*DelayProfile, error)
+	GetDelayProfile(profileID int64) (*DelayProfile, error)
+	GetDelayProfileContext(ctx context.Context, profileID int64) (*DelayProfile, error)
+	AddDelayProfile(profile *DelayProfile) (*DelayProfile, error)
+	AddDelayProfileContext(ctx context.Context, profile *DelayProfile) (*DelayProfile, error)
+	UpdateDelayProfile(profile *DelayProfile) (*DelayProfile, error)
+	UpdateDelayProfileContext(ctx context.Context, profile *DelayProfile) (*DelayProfile, error)
+	DeleteDelayProfile(profileID int64) error
+	DeleteDelayProfileContext(ctx context.Context, profileID int64) error
+}
+
+// DownloadClientAPI contains the Radarr methods for download clients.
+type DownloadClientAPI interface {
+	GetDownloadClients() ([]*DownloadClientOutput, error)
+	GetDownloadClientsContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	GetDownloadClient(downloadclientID int64) (*DownloadClientOutput, error)
+	GetDownloadClientContext(ctx context.Context, downloadclientID int64) (*DownloadClientOutput, error)
+	AddDownloadClient(downloadclient *DownloadClientInput) (*DownloadClientOutput, error)
+	AddDownloadClientContext(ctx context.Context, client *DownloadClientInput) (*DownloadClientOutput, error)
+	TestDownloadClient(client *DownloadClientInput) error
+	TestDownloadClientContext(ctx context.Context, client *DownloadClientInput) error
+	UpdateDownloadClient(downloadclient *DownloadClientInput, force bool) (*DownloadClientOutput, error)
+	UpdateDownloadClientContext(ctx context.Context,
+		client *DownloadClientInput,
+		force bool,
+	) (*DownloadClientOutput, error)
+	DeleteDownloadClient(downloadclientID int64) error
+	DeleteDownloadClientContext(ctx context.Context, downloadclientID int64) error
+	GetDownloadClientSchema() ([]*DownloadClientOutput, error)
+	GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClients(bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClientsContext(ctx context.Context, bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	DeleteBulkDownloadClients(ids []int64) error
+	DeleteBulkDownloadClientsContext(ctx context.Context, ids []int64) error
+	TestAllDownloadClients() ([]*starr.ProviderTestResult, error)
+	TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// DownloadClientConfigAPI contains the Radarr methods for download client configuration.
+type DownloadClientConfigAPI interface {
+	GetDownloadClientConfig() (*DownloadClientConfig, error)
+	GetDownloadClientConfigContext(ctx context.Context) (*DownloadClientConfig, error)
+	UpdateDownloadClientConfig(downloadClientConfig *DownloadClientConfig) (*DownloadClientConfig, error)
+	UpdateDownloadClientConfigContext(ctx context.Context, config *DownloadClientConfig) (*DownloadClientConfig, error)
+}
+
+// ExclusionAPI contains the Radarr methods for exclusions.
+type ExclusionAPI interface {
+	GetExclusions() ([]*Exclusion, error)
+	GetExclusionsContext(ctx context.Context) ([]*Exclusion, error)
+	UpdateExclusion(exclusion *Exclusion) (*Exclusion, error)
+	UpdateExclusionContext(ctx context.Context, exclusion *Exclusion) (*Exclusion, error)
+	DeleteExclusions(ids []int64) error
+	DeleteExclusionsContext(ctx context.Context, ids []int64) error
+	AddExclusions(exclusions []*Exclusion) error
+	AddExclusionsContext(ctx context.Context, exclusions []*Exclusion) error
+	AddExclusion(exclusion *Exclusion) (*Exclusion, error)
+	AddExclusionContext(ctx context.Context, exclusion *Exclusion) (*Exclusion, error)
+}
+
+// HistoryAPI contains the Radarr methods for history.
+type HistoryAPI interface {
+	GetHistory(records, perPage int) (*History, error)
+	GetHistoryContext(ctx context.Context, records, perPage int) (*History, error)
+	GetHistoryPage(params *starr.PageReq) (*History, error)
+	GetHistoryPageContext(ctx context.Context, params *starr.PageReq) (*History, error)
+	GetHistorySince(date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetHistorySinceContext(ctx context.Context, date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetMovieHistory(movieID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetMovieHistoryContext(ctx context.Context, movieID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	Fail(historyID int64) error
+	FailContext(ctx context.Context, historyID int64) error
+}
+
+// ImportListAPI contains the Radarr methods for import lists.
+type ImportListAPI interface {
+	GetImportLists() ([]*ImportListOutput, error)
+	GetImportListsContext(ctx context.Context) ([]*ImportListOutput, error)
+	AddImportList(list *ImportListInput) (*ImportListOutput, error)
+	AddImportListContext(ctx context.Context, list *ImportListInput) (*ImportListOutput, error)
+	DeleteImportList(ids []int64) error
+	DeleteImportListContext(ctx context.Context, ids []int64) error
+	TestImportList(list *ImportListInput) error
+	TestImportListContextt(ctx context.Context, list *ImportListInput) error
+	UpdateImportList(list *ImportListInput, force bool) (*ImportListOutput, error)
+	UpdateImportListContext(ctx context.Context, importList *ImportListInput, force bool) (*ImportListOutput, error)
+	GetImportListSchema() ([]*ImportListOutput, error)
+	GetImportListSchemaContext(ctx context.Context) ([]*ImportListOutput, error)
+	UpdateBulkImportLists(bulk *BulkImportList) ([]*ImportListOutput, error)
+	UpdateBulkImportListsContext(ctx context.Context, bulk *BulkImportList) ([]*ImportListOutput, error)
+	DeleteBulkImportLists(ids []int64) error
+	DeleteBulkImportListsContext(ctx context.Context, ids []int64) error
+	TestAllImportLists() ([]*starr.ProviderTestResult, error)
+	TestAllImportListsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// ImportListMovieAPI contains the Radarr methods for import list movies.
+type ImportListMovieAPI interface {
+	GetImportListMovies(input *GetImportListMovies) ([]*ImportListMovie, error)
+	GetImportListMoviesContext(ctx context.Context, input *GetImportListMovies) ([]*ImportListMovie, error)
+	SyncImportList(listID int64) (*CommandResponse, error)
+	SyncImportListContext(ctx context.Context, listID int64) (*CommandResponse, error)
+	AuditImportList(input *GetImportListMovies) (*ImportListAudit, error)
+	AuditImportListContext(ctx context.Context, input *GetImportListMovies) (*ImportListAudit, error)
+}
+
+// IndexerAPI contains the Radarr methods for indexers.
+type IndexerAPI interface {
+	GetIndexers() ([]*IndexerOutput, error)
+	GetIndexersContext(ctx context.Context) ([]*IndexerOutput, error)
+	GetIndexer(indexerID int64) (*IndexerOutput, error)
+	GetIndexerContext(ctx context.Context, indexerID int64) (*IndexerOutput, error)
+	TestIndexer(indexer *IndexerInput) error
+	TestIndexerContext(ctx context.Context, indexer *IndexerInput) error
+	AddIndexer(indexer *IndexerInput) (*IndexerOutput, error)
+	AddIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error)
+	UpdateIndexer(indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	UpdateIndexerContext(ctx context.Context, indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	DeleteIndexer(indexerID int64) error
+	DeleteIndexerContext(ctx context.Context, indexerID int64) error
+	GetIndexerSchema() ([]*IndexerOutput, error)
+	GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error)
+	UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error)
+	UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error)
+	DeleteBulkIndexers(ids []int64) error
+	DeleteBulkIndexersContext(ctx context.Context, ids []int64) error
+	TestAllIndexers() ([]*starr.ProviderTestResult, error)
+	TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// IndexerConfigAPI contains the Radarr methods for indexer configuration.
+type IndexerConfigAPI interface {
+	GetIndexerConfig() (*IndexerConfig, error)
+	GetIndexerConfigContext(ctx context.Context) (*IndexerConfig, error)
+	UpdateIndexerConfig(indexerConfig *IndexerConfig) (*IndexerConfig, error)
+	UpdateIndexerConfigContext(ctx context.Context, indexerConfig *IndexerConfig) (*IndexerConfig, error)
+}
+
+// ManualImportAPI contains the Radarr methods for manual imports.
+type ManualImportAPI interface {
+	ManualImport(params *ManualImportParams) (*ManualImportOutput, error)
+	ManualImportContext(ctx context.Context, params *ManualImportParams) (*ManualImportOutput, error)
+	ManualImportReprocess(manualimport *ManualImportInput) error
+	ManualImportReprocessContext(ctx context.Context, manualimport *ManualImportInput) error
+}
+
+// MediaManagementAPI contains the Radarr methods for media management configuration.
+type MediaManagementAPI interface {
+	GetMediaManagement() (*MediaManagement, error)
+	GetMediaManagementContext(ctx context.Context) (*MediaManagement, error)
+	UpdateMediaManagement(mMgt *MediaManagement) (*MediaManagement, error)
+	UpdateMediaManagementContext(ctx context.Context, mMgt *MediaManagement) (*MediaManagement, error)
+}
+
+// MovieAPI contains the Radarr methods for movies.
+type MovieAPI interface {
+	GetMovie(getMovie *GetMovie) ([]*Movie, error)
+	GetMovieContext(ctx context.Context, getMovie *GetMovie) ([]*Movie, error)
+	GetMovieByID(movieID int64) (*Movie, error)
+	GetMovieByIDContext(ctx context.Context, movieID int64) (*Movie, error)
+	UpdateMovie(movieID int64, movie *Movie, moveFiles bool) (*Movie, error)
+	UpdateMovieContext(ctx context.Context, movieID int64, movie *Movie, moveFiles bool) (*Movie, error)
+	AddMovie(movie *AddMovieInput) (*Movie, error)
+	AddMovieContext(ctx context.Context, movie *AddMovieInput) (*Movie, error)
+	Lookup(term string) ([]*Movie, error)
+	LookupContext(ctx context.Context, term string) ([]*Movie, error)
+	LookupID(movieID int64) (*Movie, error)
+	LookupIDContext(ctx context.Context, movieID int64) (*Movie, error)
+	LookupIMDB(imdbID string) (*Movie, error)
+	LookupIMDBContext(ctx context.Context, imdbID string) (*Movie, error)
+	LookupTMDB(tmdbID int64) (*Movie, error)
+	LookupTMDBContext(ctx context.Context, tmdbID int64) (*Movie, error)
+	DeleteMovie(movieID int64, deleteFiles, addImportExclusion bool) error
+	DeleteMovieContext(ctx context.Context, movieID int64, deleteFiles, addImportExclusion bool) error
+}
+
+// MovieEditorAPI contains the Radarr methods for the movie editor.
+type MovieEditorAPI interface {
+	EditMovies(editMovies *BulkEdit) ([]*Movie, error)
+	EditMoviesContext(ctx context.Context, editMovies *BulkEdit) ([]*Movie, error)
+	DeleteMovies(deleteMovies *BulkEdit) error
+	DeleteMoviesContext(ctx context.Context, deleteMovies *BulkEdit) error
+}
+
+// MovieFileAPI contains the Radarr methods for movie files.
+type MovieFileAPI interface {
+	GetMovieFile(movieID int64) ([]*MovieFile, error)
+	GetMovieFileContext(ctx context.Context, movieID int64) ([]*MovieFile, error)
+	GetMovieFileByID(movieFileID int64) (*MovieFile, error)
+	GetMovieFileByIDContext(ctx context.Context, movieFileID int64) (*MovieFile, error)
+	GetMovieFiles(movieFileIDs []int64) ([]*MovieFile, error)
+	GetMovieFilesContext(ctx context.Context, movieFileIDs []int64) ([]*MovieFile, error)
+	UpdateMovieFile(movieFile *MovieFile) (*MovieFile, error)
+	UpdateMovieFileContext(ctx context.Context, movieFile *MovieFile) (*MovieFile, error)
+	DeleteMovieFiles(movieFileIDs ...int64) error
+	DeleteMovieFilesContext(ctx context.Context, movieFileIDs ...int64) error
+}
+
+// NamingAPI contains the Radarr methods for naming configuration.
+type NamingAPI interface {
+	GetNaming() (*Naming, error)
+	GetNamingContext(ctx context.Context) (*Naming, error)
+	UpdateNaming(naming *Naming) (*Naming, error)
+	UpdateNamingContext(ctx context.Context, naming *Naming) (*Naming, error)
+}
+
+// NotificationAPI contains the Radarr methods for notifications.
+type NotificationAPI interface {
+	GetNotifications() ([]*NotificationOutput, error)
+	GetNotificationsContext(ctx context.Context) ([]*NotificationOutput, error)
+	GetNotification(notificationID int) (*NotificationOutput, error)
+	GetNotificationContext(ctx context.Context, notificationID int) (*NotificationOutput, error)
+	AddNotification(notification *NotificationInput) (*NotificationOutput, error)
+	AddNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	UpdateNotification(notification *NotificationInput) (*NotificationOutput, error)
+	UpdateNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	DeleteNotification(notificationID int64) error
+	DeleteNotificationContext(ctx context.Context, notificationID int64) error
+	GetNotificationSchema() ([]*NotificationOutput, error)
+	GetNotificationSchemaContext(ctx context.Context) ([]*NotificationOutput, error)
+	TestAllNotifications() ([]*starr.ProviderTestResult, error)
+	TestAllNotificationsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// ParseAPI contains the Radarr methods for parsing release titles.
+type ParseAPI interface {
+	Parse(title string) (*ParseOutput, error)
+	ParseContext(ctx context.Context, title string) (*ParseOutput, error)
+}
+
+// QualityDefinitionAPI contains the Radarr methods for quality definitions.
+type QualityDefinitionAPI interface {
+	GetQualityDefinitions() ([]*QualityDefinition, error)
+	GetQualityDefinitionsContext(ctx context.Context) ([]*QualityDefinition, error)
+	GetQualityDefinition(qualityDefinitionID int64) (*QualityDefinition, error)
+	GetQualityDefinitionContext(ctx context.Context, qdID int64) (*QualityDefinition, error)
+	UpdateQualityDefinition(definition *QualityDefinition) (*QualityDefinition, error)
+	UpdateQualityDefinitionContext(ctx context.Context, definition *QualityDefinition) (*QualityDefinition, error)
+	UpdateQualityDefinitions(definition []*QualityDefinition) ([]*QualityDefinition, error)
+	UpdateQualityDefinitionsContext(ctx context.Context, definition []*QualityDefinition) ([]*QualityDefinition, error)
+}
+
+// QualityProfileAPI contains the Radarr methods for quality profiles.
+type QualityProfileAPI interface {
+	GetQualityProfiles() ([]*QualityProfile, error)
+	GetQualityProfilesContext(ctx context.Context) ([]*QualityProfile, error)
+	GetQualityProfile(profileID int64) (*QualityProfile, error)
+	GetQualityProfileContext(ctx context.Context, profileID int64) (*QualityProfile, error)
+	AddQualityProfile(profile *QualityProfile) (*QualityProfile, error)
+	AddQualityProfileContext(ctx context.Context, profile *QualityProfile) (*QualityProfile, error)
+	UpdateQualityProfile(profile *QualityProfile) (*QualityProfile, error)
+	UpdateQualityProfileContext(ctx context.Context, profile *QualityProfile) (*QualityProfile, error)
+	DeleteQualityProfile(profileID int64) error
+	DeleteQualityProfileContext(ctx context.Context, profileID int64) error
+}
+
+// QueueAPI contains the Radarr methods for the activity queue.
+type QueueAPI interface {
+	GetQueue(records, perPage int) (*Queue, error)
+	GetQueueContext(ctx context.Context, records, perPage int) (*Queue, error)
+	GetQueuePage(params *starr.PageReq) (*Queue, error)
+	GetQueuePageContext(ctx context.Context, params *starr.PageReq) (*Queue, error)
+	GetQueueDetails(movieID int64) ([]*QueueRecord, error)
+	GetQueueDetailsContext(ctx context.Context, movieID int64) ([]*QueueRecord, error)
+	GetQueueStatus() (*starr.QueueStatus, error)
+	GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error)
+	DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteQueueContext(ctx context.Context, queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	QueueGrab(ids ...int64) error
+	QueueGrabContext(ctx context.Context, ids ...int64) error
+}
+
+// ReleaseProfileAPI contains the Radarr methods for release profiles.
+type ReleaseProfileAPI interface {
+	GetReleaseProfiles() ([]*ReleaseProfile, error)
+	GetReleaseProfilesContext(ctx context.Context) ([]*ReleaseProfile, error)
+	GetReleaseProfile(profileID int64) (*ReleaseProfile, error)
+	GetReleaseProfileContext(ctx context.Context, profileID int64) (*ReleaseProfile, error)
+	AddReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile, error)
+	AddReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error)
+	UpdateReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile, error)
+	UpdateReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error)
+	DeleteReleaseProfile(profileID int64) error
+	DeleteReleaseProfileContext(ctx context.Context, profileID int64) error
+}
+
+// RemotePathMappingAPI contains the Radarr methods for remote path mappings.
+type RemotePathMappingAPI interface {
+	GetRemotePathMappings() ([]*starr.RemotePathMapping, error)
+	GetRemotePathMappingsContext(ctx context.Context) ([]*starr.RemotePathMapping, error)
+	GetRemotePathMapping(mappingID int64) (*starr.RemotePathMapping, error)
+	GetRemotePathMappingContext(ctx context.Context, mappingID int64) (*starr.RemotePathMapping, error)
+	AddRemotePathMapping(mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	AddRemotePathMappingContext(ctx context.Context, mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	UpdateRemotePathMapping(mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	UpdateRemotePathMappingContext(ctx context.Context,
+		mapping *starr.RemotePathMapping,
+	) (*starr.RemotePathMapping, error)
+	DeleteRemotePathMapping(mappingID int64) error
+	DeleteRemotePathMappingContext(ctx context.Context, mappingID int64) error
+}
+
+// RenameAPI contains the Radarr methods for rename previews.
+type RenameAPI interface {
+	GetRenames(movieID int64) ([]*Rename, error)
+	GetRenamesContext(ctx context.Context, movieID int64) ([]*Rename, error)
+}
+
+// RestrictionAPI contains the Radarr methods for restrictions.
+type RestrictionAPI interface {
+	GetRestrictions() ([]*Restriction, error)
+	GetRestrictionsContext(ctx context.Context) ([]*Restriction, error)
+	GetRestriction(restrictionID int64) (*Restriction, error)
+	GetRestrictionContext(ctx context.Context, restrictionID int64) (*Restriction, error)
+	AddRestriction(restriction *Restriction) (*Restriction, error)
+	AddRestrictionContext(ctx context.Context, restriction *Restriction) (*Restriction, error)
+	UpdateRestriction(restriction *Restriction) (*Restriction, error)
+	UpdateRestrictionContext(ctx context.Context, restriction *Restriction) (*Restriction, error)
+	DeleteRestriction(restrictionID int64) error
+	DeleteRestrictionContext(ctx context.Context, restrictionID int64) error
+}
+
+// RootFolderAPI contains the Radarr methods for root folders.
+type RootFolderAPI interface {
+	GetRootFolders() ([]*RootFolder, error)
+	GetRootFoldersContext(ctx context.Context) ([]*RootFolder, error)
+	GetRootFolder(folderID int64) (*RootFolder, error)
+	GetRootFolderContext(ctx context.Context, folderID int64) (*RootFolder, error)
+	AddRootFolder(folder *RootFolder) (*RootFolder, error)
+	AddRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error)
+	DeleteRootFolder(folderID int64) error
+	DeleteRootFolderContext(ctx context.Context, folderID int64) error
+}
+
+// SystemAPI contains the Radarr methods for system status, backups, logs and other system endpoints.
+type SystemAPI interface {
+	Ping() error
+	PingContext(ctx context.Context) error
+	GetSystemStatus() (*SystemStatus, error)
+	GetSystemStatusContext(ctx context.Context) (*SystemStatus, error)
+	GetBackupFiles() ([]*starr.BackupFile, error)
+	GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error)
+	GetDiskSpace() ([]*starr.DiskSpace, error)
+	GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error)
+	GetHealth() ([]*starr.Health, error)
+	GetHealthContext(ctx context.Context) ([]*starr.Health, error)
+}
+
+// TagAPI contains the Radarr methods for tags.
+type TagAPI interface {
+	GetTags() ([]*starr.Tag, error)
+	GetTagsContext(ctx context.Context) ([]*starr.Tag, error)
+	GetTag(tagID int) (*starr.Tag, error)
+	GetTagContext(ctx context.Context, tagID int) (*starr.Tag, error)
+	AddTag(tag *starr.Tag) (*starr.Tag, error)
+	AddTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	UpdateTag(tag *starr.Tag) (*starr.Tag, error)
+	UpdateTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	DeleteTag(tagID int) error
+	DeleteTagContext(ctx context.Context, tagID int) error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path"
 	"time"
 
 	"golift.io/starr"
@@ -47,10 +48,6 @@ func (r *Radarr) GetCalendarContext(ctx context.Context, filter Calendar) ([]*Mo
 	return output, nil
 }
 
-/*
-// I could not get this method to work in Radar..
-// https://discord.com/channels/264387956343570434/264387994302021632/1058509574107312168
-
 // GetCalendarID returns a single calendar by ID.
 func (r *Radarr) GetCalendarID(calendarID int64) (*Movie, error) {
 	return r.GetCalendarIDContext(context.Background(), calendarID)
@@ -66,4 +63,4 @@ func (r *Radarr) GetCalendarIDContext(ctx context.Context, calendarID int64) (*M
 	}
 
 	return output, nil
-} /**/
+}
@@ -194,4 +194,41 @@ func TestGetCalendar(t *testing.T) {
 	}
 }
 
-/**/
+func TestGetCalendarID(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   "/api/v3/calendar/2295",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   testMovieJSON,
+			WithRequest:    int64(2295),
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   &testMovieStruct,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   "/api/v3/calendar/2295",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			ExpectedMethod: http.MethodGet,
+			WithRequest:    int64(2295),
+			WithResponse:   (*radarr.Movie)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetCalendarID(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
@@ -16,7 +16,11 @@ const bpCommand = APIver + "/command"
 // This was created from the search command and may not support other commands yet.
 type CommandRequest struct {
 	Name     string  `json:"name"`
+	Files    []int64 `json:"files,omitempty"`   // RenameFiles only
+	MovieID  int64   `json:"movieId,omitempty"` // RenameFiles only
 	MovieIDs []int64 `json:"movieIds,omitempty"`
+	// DefinitionID is used by ImportListSync to sync a single list. 0 syncs all lists.
+	DefinitionID int64 `json:"definitionId,omitempty"`
 }
 
 // CommandResponse comes from the /api/v3/command endpoint.
@@ -506,3 +506,49 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateBulkDownloadClients(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "PUT",
+			ExpectedRequest: `{"ids":[4],"tags":[1],"applyTags":"remove","enable":true}` + "\n",
+			ResponseStatus:  202,
+			ResponseBody:    `[{"id":4,"enable":true,"tags":[]}]`,
+			WithRequest: &radarr.BulkDownloadClient{
+				IDs:       []int64{4},
+				Tags:      []int{1},
+				ApplyTags: starr.TagsRemove.Ptr(),
+				Enable:    starr.True(),
+			},
+			WithResponse: []*radarr.DownloadClientOutput{{ID: 4, Enable: true, Tags: []int{}}},
+			WithError:    nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "downloadClient", "bulk"),
+			ExpectedMethod:  "PUT",
+			ExpectedRequest: `{"ids":[4]}` + "\n",
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     &radarr.BulkDownloadClient{IDs: []int64{4}},
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*radarr.DownloadClientOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateBulkDownloadClients(test.WithRequest.(*radarr.BulkDownloadClient))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
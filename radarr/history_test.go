@@ -0,0 +1,91 @@
+package radarr_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestHistoryRecordData(t *testing.T) {
+	t.Parallel()
+
+	var records []*radarr.HistoryRecord
+
+	err := json.Unmarshal([]byte(`[
+		{"eventType": "downloadFailed", "data": {"message": "Download failed", "indexer": "Indexer", "size": "42",
+		 "downloadClient": "SABnzbd", "downloadClientName": "Sab", "protocol": "usenet", "releaseGroup": "GROUP"}},
+		{"eventType": "movieFileDeleted", "data": {"reason": "MissingFromDisk"}},
+		{"eventType": "movieFileRenamed", "data": {"sourcePath": "/a.mkv", "path": "/b.mkv"}},
+		{"eventType": "downloadIgnored", "data": {}},
+		{"eventType": "movieFolderImported", "data": {"droppedPath": "/downloads/a.mkv", "importedPath": "/movies/a.mkv"}}
+	]`), &records)
+	require.NoError(t, err)
+
+	assert.Equal(t, radarr.FilterDownloadFailed, records[0].Filter())
+	assert.Equal(t, &starr.FailedData{
+		Message:            "Download failed",
+		Indexer:            "Indexer",
+		ReleaseGroup:       "GROUP",
+		Size:               42,
+		DownloadClient:     "SABnzbd",
+		DownloadClientName: "Sab",
+		Protocol:           "usenet",
+	}, records[0].Failed())
+	assert.Nil(t, records[0].Grabbed())
+
+	assert.Equal(t, radarr.FilterFileDeleted, records[1].Filter())
+	assert.Equal(t, &starr.DeletedData{Reason: "MissingFromDisk"}, records[1].Deleted())
+
+	assert.Equal(t, radarr.FilterRenamed, records[2].Filter())
+	assert.Equal(t, &starr.RenamedData{SourcePath: "/a.mkv", Path: "/b.mkv"}, records[2].Renamed())
+
+	assert.Equal(t, radarr.FilterIgnored, records[3].Filter())
+	assert.Nil(t, records[3].Imported())
+
+	assert.Equal(t, radarr.FilterFolderImported, records[4].Filter())
+	assert.Equal(t, &starr.ImportedData{DroppedPath: "/downloads/a.mkv", ImportedPath: "/movies/a.mkv"}, records[4].Imported())
+}
+
+func TestGetMovieHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "history/movie?eventType=1&movieId=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "movieId": 7, "eventType": "grabbed"}]`,
+			WithResponse:   []*radarr.HistoryRecord{{ID: 1, MovieID: 7, EventType: "grabbed"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "history/movie?eventType=1&movieId=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*radarr.HistoryRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMovieHistory(7, radarr.FilterGrabbed)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
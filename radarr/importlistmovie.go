@@ -0,0 +1,165 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"golift.io/starr"
+)
+
+// ImportListSyncCommand is the command name used to sync import lists.
+const ImportListSyncCommand = "ImportListSync"
+
+// ImportListMovie is returned by the /api/v3/importlist/movie endpoint.
+// These are the movies that import lists would add to Radarr.
+type ImportListMovie struct {
+	Title            string            `json:"title"`
+	SortTitle        string            `json:"sortTitle"`
+	Overview         string            `json:"overview"`
+	InCinemas        time.Time         `json:"inCinemas,omitempty"`
+	PhysicalRelease  time.Time         `json:"physicalRelease,omitempty"`
+	DigitalRelease   time.Time         `json:"digitalRelease,omitempty"`
+	Images           []*starr.Image    `json:"images"`
+	Website          string            `json:"website"`
+	RemotePoster     string            `json:"remotePoster"`
+	Year             int               `json:"year"`
+	YouTubeTrailerID string            `json:"youTubeTrailerId"`
+	Studio           string            `json:"studio"`
+	Runtime          int               `json:"runtime"`
+	ImdbID           string            `json:"imdbId"`
+	TmdbID           int64             `json:"tmdbId"`
+	Folder           string            `json:"folder"`
+	Certification    string            `json:"certification"`
+	Genres           []string          `json:"genres"`
+	Ratings          starr.OpenRatings `json:"ratings"`
+	Collection       *Collection       `json:"collection"`
+	IsExcluded       bool              `json:"isExcluded"`
+	IsExisting       bool              `json:"isExisting"`
+	IsRecommendation bool              `json:"isRecommendation"`
+	// Lists contains the IDs of the import lists that provided this movie.
+	Lists []int64 `json:"lists"`
+}
+
+// GetImportListMovies represents the input parameters for an import list movie request.
+type GetImportListMovies struct {
+	IncludeRecommendations bool
+	IncludeTrending        bool
+	IncludePopular         bool
+}
+
+// ImportListAudit is the output from AuditImportListMovies.
+// It cross-references the movies import lists provide with the movies already in Radarr.
+type ImportListAudit struct {
+	// New contains list movies that are not in Radarr, and not excluded.
+	New []*ImportListMovie
+	// Existing contains list movies that are already in Radarr.
+	Existing []*ImportListMatch
+	// Excluded contains list movies that are on the import list exclusion list.
+	Excluded []*ImportListMovie
+}
+
+// ImportListMatch pairs an import list movie with the existing Radarr movie.
+type ImportListMatch struct {
+	ListMovie *ImportListMovie
+	Movie     *Movie
+}
+
+// GetImportListMovies returns the movies your import lists would add.
+// Pass nil to use the Radarr defaults.
+func (r *Radarr) GetImportListMovies(input *GetImportListMovies) ([]*ImportListMovie, error) {
+	return r.GetImportListMoviesContext(context.Background(), input)
+}
+
+// GetImportListMoviesContext returns the movies your import lists would add.
+// Pass nil to use the Radarr defaults.
+func (r *Radarr) GetImportListMoviesContext(
+	ctx context.Context,
+	input *GetImportListMovies,
+) ([]*ImportListMovie, error) {
+	var output []*ImportListMovie
+
+	req := starr.Request{URI: path.Join(bpImportList, "movie"), Query: make(url.Values)}
+	if input != nil {
+		req.Query.Set("includeRecommendations", fmt.Sprint(input.IncludeRecommendations))
+		req.Query.Set("includeTrending", fmt.Sprint(input.IncludeTrending))
+		req.Query.Set("includePopular", fmt.Sprint(input.IncludePopular))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// SyncImportList triggers an import list sync. Pass 0 for listID to sync every list.
+func (r *Radarr) SyncImportList(listID int64) (*CommandResponse, error) {
+	return r.SyncImportListContext(context.Background(), listID)
+}
+
+// SyncImportListContext triggers an import list sync. Pass 0 for listID to sync every list.
+func (r *Radarr) SyncImportListContext(ctx context.Context, listID int64) (*CommandResponse, error) {
+	return r.SendCommandContext(ctx, &CommandRequest{Name: ImportListSyncCommand, DefinitionID: listID})
+}
+
+// AuditImportList retrieves your import list movies and your existing movies, and cross-references them.
+// This is useful to check what an import list will add before enabling automatic adds.
+func (r *Radarr) AuditImportList(input *GetImportListMovies) (*ImportListAudit, error) {
+	return r.AuditImportListContext(context.Background(), input)
+}
+
+// AuditImportListContext retrieves your import list movies and your existing movies, and cross-references them.
+func (r *Radarr) AuditImportListContext(ctx context.Context, input *GetImportListMovies) (*ImportListAudit, error) {
+	listMovies, err := r.GetImportListMoviesContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := r.GetMovieContext(ctx, &GetMovie{ExcludeLocalCovers: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return AuditImportListMovies(listMovies, movies), nil
+}
+
+// AuditImportListMovies cross-references import list movies with existing movies.
+// Movies are matched by TMDB ID first, and IMDB ID second.
+func AuditImportListMovies(listMovies []*ImportListMovie, movies []*Movie) *ImportListAudit {
+	var (
+		output = &ImportListAudit{}
+		tmdb   = make(map[int64]*Movie)
+		imdb   = make(map[string]*Movie)
+	)
+
+	for _, movie := range movies {
+		if movie.TmdbID != 0 {
+			tmdb[movie.TmdbID] = movie
+		}
+
+		if movie.ImdbID != "" {
+			imdb[movie.ImdbID] = movie
+		}
+	}
+
+	for _, listMovie := range listMovies {
+		movie := tmdb[listMovie.TmdbID]
+		if movie == nil && listMovie.ImdbID != "" {
+			movie = imdb[listMovie.ImdbID]
+		}
+
+		switch {
+		case movie != nil:
+			output.Existing = append(output.Existing, &ImportListMatch{ListMovie: listMovie, Movie: movie})
+		case listMovie.IsExcluded:
+			output.Excluded = append(output.Excluded, listMovie)
+		default:
+			output.New = append(output.New, listMovie)
+		}
+	}
+
+	return output
+}
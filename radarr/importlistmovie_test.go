@@ -0,0 +1,112 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const importListMovieBody = `[{
+	"title": "Movie One",
+	"sortTitle": "movie one",
+	"year": 2021,
+	"imdbId": "tt0000001",
+	"tmdbId": 101,
+	"isExcluded": false,
+	"isExisting": true,
+	"isRecommendation": false,
+	"lists": [1, 2]
+}]`
+
+func TestGetImportListMovies(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver,
+				"importlist", "movie?includePopular=false&includeRecommendations=true&includeTrending=false"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   importListMovieBody,
+			WithRequest:    &radarr.GetImportListMovies{IncludeRecommendations: true},
+			WithResponse: []*radarr.ImportListMovie{{
+				Title:      "Movie One",
+				SortTitle:  "movie one",
+				Year:       2021,
+				ImdbID:     "tt0000001",
+				TmdbID:     101,
+				IsExisting: true,
+				Lists:      []int64{1, 2},
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "importlist", "movie"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    (*radarr.GetImportListMovies)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*radarr.ImportListMovie)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetImportListMovies(test.WithRequest.(*radarr.GetImportListMovies))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestSyncImportList(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "command"),
+		ExpectedMethod:  http.MethodPost,
+		ExpectedRequest: `{"name":"ImportListSync","definitionId":3}` + "\n",
+		ResponseStatus:  http.StatusCreated,
+		ResponseBody:    `{"id":55,"name":"ImportListSync","status":"queued"}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.SyncImportList(3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, &radarr.CommandResponse{ID: 55, Name: "ImportListSync", Status: "queued"}, output)
+}
+
+func TestAuditImportListMovies(t *testing.T) {
+	t.Parallel()
+
+	listMovies := []*radarr.ImportListMovie{
+		{Title: "Existing by TMDB", TmdbID: 1},
+		{Title: "Existing by IMDB", ImdbID: "tt2"},
+		{Title: "Excluded", TmdbID: 3, IsExcluded: true},
+		{Title: "New", TmdbID: 4, ImdbID: "tt4"},
+	}
+	movies := []*radarr.Movie{
+		{ID: 10, TmdbID: 1},
+		{ID: 20, ImdbID: "tt2"},
+	}
+
+	audit := radarr.AuditImportListMovies(listMovies, movies)
+	assert.Len(t, audit.Existing, 2)
+	assert.Equal(t, int64(10), audit.Existing[0].Movie.ID)
+	assert.Equal(t, int64(20), audit.Existing[1].Movie.ID)
+	assert.Equal(t, []*radarr.ImportListMovie{listMovies[2]}, audit.Excluded)
+	assert.Equal(t, []*radarr.ImportListMovie{listMovies[3]}, audit.New)
+}
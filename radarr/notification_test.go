@@ -466,3 +466,46 @@ func TestDeleteNotification(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNotificationSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "notification", "schema"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody: `[{"supportsOnGrab":true,"implementation":"Discord","configContract":"DiscordSettings",` +
+				`"fields":[{"name":"webHookUrl","type":"url"}]}]`,
+			WithResponse: []*radarr.NotificationOutput{{
+				SupportsOnGrab: true,
+				Implementation: "Discord",
+				ConfigContract: "DiscordSettings",
+				Fields:         []*starr.FieldOutput{{Name: "webHookUrl", Type: "url"}},
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "notification", "schema"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*radarr.NotificationOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetNotificationSchema()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
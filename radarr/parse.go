@@ -0,0 +1,63 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpParse = APIver + "/parse"
+
+// ParsedMovieInfo is the information parsed from a movie release title.
+type ParsedMovieInfo struct {
+	MovieTitles        []string       `json:"movieTitles"`
+	OriginalTitle      string         `json:"originalTitle"`
+	ReleaseTitle       string         `json:"releaseTitle"`
+	SimpleReleaseTitle string         `json:"simpleReleaseTitle"`
+	Quality            *starr.Quality `json:"quality"`
+	Languages          []*starr.Value `json:"languages"`
+	ReleaseGroup       string         `json:"releaseGroup"`
+	ReleaseHash        string         `json:"releaseHash"`
+	Edition            string         `json:"edition"`
+	Year               int            `json:"year"`
+	ImdbID             string         `json:"imdbId"`
+	TmdbID             int64          `json:"tmdbId"`
+	HardcodedSubs      string         `json:"hardcodedSubs"`
+	MovieTitle         string         `json:"movieTitle"`
+	PrimaryMovieTitle  string         `json:"primaryMovieTitle"`
+}
+
+// ParseOutput is what you get from the parse endpoint when you provide a parsable title.
+type ParseOutput struct {
+	ID                int64                 `json:"id"`
+	Title             string                `json:"title"`
+	Movie             *Movie                `json:"movie"`
+	Languages         []*starr.Value        `json:"languages"`
+	CustomFormats     []*CustomFormatOutput `json:"customFormats"`
+	CustomFormatScore int64                 `json:"customFormatScore"`
+	// You need to check this for nil before accessing it.
+	// If the parse failed, this won't exist, and you won't get an error.
+	ParsedMovieInfo *ParsedMovieInfo `json:"parsedMovieInfo"`
+}
+
+// Parse a release title into movie info.
+// Movie is only included if the title matched a movie in Radarr.
+func (r *Radarr) Parse(title string) (*ParseOutput, error) {
+	return r.ParseContext(context.Background(), title)
+}
+
+// ParseContext parses a release title into movie info.
+func (r *Radarr) ParseContext(ctx context.Context, title string) (*ParseOutput, error) {
+	var output *ParseOutput
+
+	req := starr.Request{URI: bpParse, Query: make(url.Values)}
+	req.Query.Set("title", title)
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,97 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const parseBody = `{
+	"title": "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+	"parsedMovieInfo": {
+		"movieTitles": ["Movie Title"],
+		"originalTitle": "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+		"releaseTitle": "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+		"simpleReleaseTitle": "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+		"quality": {
+			"quality": {"id": 7, "name": "Bluray-1080p", "source": "bluray", "resolution": 1080, "modifier": "none"},
+			"revision": {"version": 1, "real": 0, "isRepack": false}
+		},
+		"languages": [{"id": 1, "name": "English"}],
+		"releaseGroup": "GROUP",
+		"edition": "",
+		"year": 2019,
+		"imdbId": "",
+		"tmdbId": 0,
+		"movieTitle": "Movie Title",
+		"primaryMovieTitle": "Movie Title"
+	},
+	"movie": {"id": 3, "title": "Movie Title", "year": 2019, "tmdbId": 1234},
+	"languages": [{"id": 1, "name": "English"}],
+	"customFormats": [],
+	"customFormatScore": 0
+}`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "parse?title=Movie.Title.2019.1080p.BluRay.x264-GROUP"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   parseBody,
+			WithResponse: &radarr.ParseOutput{
+				Title: "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+				ParsedMovieInfo: &radarr.ParsedMovieInfo{
+					MovieTitles:        []string{"Movie Title"},
+					OriginalTitle:      "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+					ReleaseTitle:       "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+					SimpleReleaseTitle: "Movie.Title.2019.1080p.BluRay.x264-GROUP",
+					Quality: &starr.Quality{
+						Quality: &starr.BaseQuality{
+							ID: 7, Name: "Bluray-1080p", Source: "bluray", Resolution: 1080, Modifier: "none",
+						},
+						Revision: &starr.QualityRevision{Version: 1},
+					},
+					Languages:         []*starr.Value{{ID: 1, Name: "English"}},
+					ReleaseGroup:      "GROUP",
+					Year:              2019,
+					MovieTitle:        "Movie Title",
+					PrimaryMovieTitle: "Movie Title",
+				},
+				Movie:         &radarr.Movie{ID: 3, Title: "Movie Title", Year: 2019, TmdbID: 1234},
+				Languages:     []*starr.Value{{ID: 1, Name: "English"}},
+				CustomFormats: []*radarr.CustomFormatOutput{},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "parse?title=Movie.Title.2019.1080p.BluRay.x264-GROUP"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*radarr.ParseOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Parse("Movie.Title.2019.1080p.BluRay.x264-GROUP")
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
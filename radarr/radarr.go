@@ -28,7 +28,7 @@ const (
 	FilterDownloadFailed
 	_ // 5 is unused. FilterDeleted
 	FilterFileDeleted
-	_ // FilterFolderImported // not used yet, 1/17/2022
+	FilterFolderImported
 	FilterRenamed
 	FilterIgnored
 )
@@ -0,0 +1,40 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpRename = APIver + "/rename"
+
+// Rename is the /api/v3/rename endpoint.
+// This is a preview of the changes the RenameFiles command will make.
+type Rename struct {
+	MovieID      int64  `json:"movieId"`
+	MovieFileID  int64  `json:"movieFileId"`
+	ExistingPath string `json:"existingPath"`
+	NewPath      string `json:"newPath"`
+}
+
+// GetRenames returns the files that would be renamed for a movie.
+// Pass the MovieFileID values to SendCommand with a RenameFiles command to apply the changes.
+func (r *Radarr) GetRenames(movieID int64) ([]*Rename, error) {
+	return r.GetRenamesContext(context.Background(), movieID)
+}
+
+// GetRenamesContext returns the files that would be renamed for a movie.
+func (r *Radarr) GetRenamesContext(ctx context.Context, movieID int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: make(url.Values)}
+	req.Query.Set("movieId", starr.Itoa(movieID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
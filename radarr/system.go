@@ -9,7 +9,11 @@ import (
 	"golift.io/starr"
 )
 
-const bpSystem = APIver + "/system"
+const (
+	bpSystem    = APIver + "/system"
+	bpDiskSpace = APIver + "/diskspace"
+	bpHealth    = APIver + "/health"
+)
 
 // SystemStatus is the /api/v3/system/status endpoint.
 type SystemStatus struct {
@@ -79,3 +83,37 @@ func (r *Radarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile
 
 	return output, nil
 }
+
+// GetDiskSpace returns the free and total space for every disk Radarr uses.
+func (r *Radarr) GetDiskSpace() ([]*starr.DiskSpace, error) {
+	return r.GetDiskSpaceContext(context.Background())
+}
+
+// GetDiskSpaceContext returns the free and total space for every disk Radarr uses.
+func (r *Radarr) GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error) {
+	var output []*starr.DiskSpace
+
+	req := starr.Request{URI: bpDiskSpace}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetHealth returns the health check warnings and errors from Radarr.
+func (r *Radarr) GetHealth() ([]*starr.Health, error) {
+	return r.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns the health check warnings and errors from Radarr.
+func (r *Radarr) GetHealthContext(ctx context.Context) ([]*starr.Health, error) {
+	var output []*starr.Health
+
+	req := starr.Request{URI: bpHealth}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
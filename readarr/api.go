@@ -0,0 +1,375 @@
+package readarr
+
+import (
+	"context"
+	"time"
+
+	"golift.io/starr"
+)
+
+/* This file contains interfaces for the Readarr methods, grouped by resource.
+ * Depend on one of these, or on API, instead of *Readarr to swap in a fake during tests.
+ * The starrtest/mocks package has a ready-made Readarr mock that satisfies API.
+ */
+
+// API contains every Readarr method, except the methods from the embedded starr.APIer.
+type API interface {
+	AuthorAPI
+	BlocklistAPI
+	BookAPI
+	BookFileAPI
+	CalendarAPI
+	CommandAPI
+	DownloadClientAPI
+	DownloadClientConfigAPI
+	ExclusionAPI
+	HistoryAPI
+	ImportListAPI
+	IndexerAPI
+	IndexerConfigAPI
+	ManualImportAPI
+	MediaManagementAPI
+	MetadataProfileAPI
+	NamingAPI
+	NotificationAPI
+	ParseAPI
+	QualityProfileAPI
+	QueueAPI
+	RemotePathMappingAPI
+	RenameAPI
+	RootFolderAPI
+	SystemAPI
+	TagAPI
+}
+
+// Readarr must satisfy the API interface.
+var _ API = (*Readarr)(nil)
+
+// AuthorAPI contains the Readarr methods for authors.
+type AuthorAPI interface {
+	GetAuthorByID(authorID int64) (*Author, error)
+	GetAuthorByIDContext(ctx context.Context, authorID int64) (*Author, error)
+	UpdateAuthor(author *Author, moveFiles bool) (*Author, error)
+	UpdateAuthorContext(ctx context.Context, author *Author, moveFiles bool) (*Author, error)
+	DeleteAuthor(authorID int64, deleteFiles, addImportExclusion bool) error
+	DeleteAuthorContext(ctx context.Context, authorID int64, deleteFiles, addImportExclusion bool) error
+}
+
+// BlocklistAPI contains the Readarr methods for the blocklist.
+type BlocklistAPI interface {
+	GetBlockList(count int) (*BlockList, error)
+	GetBlockListContext(ctx context.Context, records int) (*BlockList, error)
+	GetBlockListPage(params *starr.PageReq) (*BlockList, error)
+	GetBlockListPageContext(ctx context.Context, params *starr.PageReq) (*BlockList, error)
+	DeleteBlockList(listID int64) error
+	DeleteBlockListContext(ctx context.Context, listID int64) error
+	DeleteBlockLists(ids []int64) error
+	DeleteBlockListsContext(ctx context.Context, ids []int64) error
+}
+
+// BookAPI contains the Readarr methods for books.
+type BookAPI interface {
+	GetBook(gridID string) ([]*Book, error)
+	GetBookContext(ctx context.Context, gridID string) ([]*Book, error)
+	GetBookByID(bookID int64) (*Book, error)
+	GetBookByIDContext(ctx context.Context, bookID int64) (*Book, error)
+	UpdateBook(bookID int64, book *Book, moveFiles bool) error
+	UpdateBookContext(ctx context.Context, bookID int64, book *Book, moveFiles bool) error
+	AddBook(book *AddBookInput) (*Book, error)
+	AddBookContext(ctx context.Context, book *AddBookInput) (*Book, error)
+	Lookup(term string) ([]*Book, error)
+	LookupContext(ctx context.Context, term string) ([]*Book, error)
+	DeleteBook(bookID int64, deleteFiles, addImportExclusion bool) error
+	DeleteBookContext(ctx context.Context, bookID int64, deleteFiles, addImportExclusion bool) error
+}
+
+// BookFileAPI contains the Readarr methods for book files.
+type BookFileAPI interface {
+	GetBookFilesForAuthor(authorID int64) ([]*BookFile, error)
+	GetBookFilesForAuthorContext(ctx context.Context, authorID int64) ([]*BookFile, error)
+	GetBookFilesForBook(bookID ...int64) ([]*BookFile, error)
+	GetBookFilesForBookContext(ctx context.Context, bookID ...int64) ([]*BookFile, error)
+	GetBookFiles(bookFileIDs []int64) ([]*BookFile, error)
+	GetBookFilesContext(ctx context.Context, bookFileIDs []int64) ([]*BookFile, error)
+	UpdateBookFile(bookFile *BookFile) (*BookFile, error)
+	UpdateBookFileContext(ctx context.Context, bookFile *BookFile) (*BookFile, error)
+	DeleteBookFile(bookFileID int64) error
+	DeleteBookFileContext(ctx context.Context, bookFileID int64) error
+	DeleteBookFiles(bookFileIDs []int64) error
+	DeleteBookFilesContext(ctx context.Context, bookFileIDs []int64) error
+}
+
+// CalendarAPI contains the Readarr methods for the calendar.
+type CalendarAPI interface {
+	GetCalendar(filter Calendar) ([]*Book, error)
+	GetCalendarContext(ctx context.Context, filter Calendar) ([]*Book, error)
+	GetCalendarID(calendarID int64) (*Book, error)
+	GetCalendarIDContext(ctx context.Context, calendarID int64) (*Book, error)
+}
+
+// CommandAPI contains the Readarr methods for commands.
+type CommandAPI interface {
+	GetCommands() ([]*CommandResponse, error)
+	GetCommandsContext(ctx context.Context) ([]*CommandResponse, error)
+	SendCommand(cmd *CommandRequest) (*CommandResponse, error)
+	SendCommandContext(ctx context.Context, cmd *CommandRequest) (*CommandResponse, error)
+}
+
+// DownloadClientAPI contains the Readarr methods for download clients.
+type DownloadClientAPI interface {
+	GetDownloadClients() ([]*DownloadClientOutput, error)
+	GetDownloadClientsContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	GetDownloadClient(downloadclientID int64) (*DownloadClientOutput, error)
+	GetDownloadClientContext(ctx context.Context, downloadclientID int64) (*DownloadClientOutput, error)
+	AddDownloadClient(downloadclient *DownloadClientInput) (*DownloadClientOutput, error)
+	AddDownloadClientContext(ctx context.Context, client *DownloadClientInput) (*DownloadClientOutput, error)
+	TestDownloadClient(client *DownloadClientInput) error
+	TestDownloadClientContext(ctx context.Context, client *DownloadClientInput) error
+	UpdateDownloadClient(downloadclient *DownloadClientInput, force bool) (*DownloadClientOutput, error)
+	UpdateDownloadClientContext(ctx context.Context,
+		client *DownloadClientInput,
+		force bool,
+	) (*DownloadClientOutput, error)
+	DeleteDownloadClient(downloadclientID int64) error
+	DeleteDownloadClientContext(ctx context.Context, downloadclientID int64) error
+	GetDownloadClientSchema() ([]*DownloadClientOutput, error)
+	GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClients(bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClientsContext(ctx context.Context, bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	DeleteBulkDownloadClients(ids []int64) error
+	DeleteBulkDownloadClientsContext(ctx context.Context, ids []int64) error
+	TestAllDownloadClients() ([]*starr.ProviderTestResult, error)
+	TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// DownloadClientConfigAPI contains the Readarr methods for download client configuration.
+type DownloadClientConfigAPI interface {
+	GetDownloadClientConfig() (*DownloadClientConfig, error)
+	GetDownloadClientConfigContext(ctx context.Context) (*DownloadClientConfig, error)
+	UpdateDownloadClientConfig(downloadConfig *DownloadClientConfig) (*DownloadClientConfig, error)
+	UpdateDownloadClientConfigContext(ctx context.Context, config *DownloadClientConfig) (*DownloadClientConfig, error)
+}
+
+// ExclusionAPI contains the Readarr methods for exclusions.
+type ExclusionAPI interface {
+	GetExclusions() ([]*Exclusion, error)
+	GetExclusionsContext(ctx context.Context) ([]*Exclusion, error)
+	UpdateExclusion(exclusion *Exclusion) (*Exclusion, error)
+	UpdateExclusionContext(ctx context.Context, exclusion *Exclusion) (*Exclusion, error)
+	DeleteExclusions(ids []int64) error
+	DeleteExclusionsContext(ctx context.Context, ids []int64) error
+	AddExclusion(exclusion *Exclusion) (*Exclusion, error)
+	AddExclusionContext(ctx context.Context, exclusion *Exclusion) (*Exclusion, error)
+}
+
+// HistoryAPI contains the Readarr methods for history.
+type HistoryAPI interface {
+	GetHistory(records, perPage int) (*History, error)
+	GetHistoryContext(ctx context.Context, records, perPage int) (*History, error)
+	GetHistoryPage(params *starr.PageReq) (*History, error)
+	GetHistoryPageContext(ctx context.Context, params *starr.PageReq) (*History, error)
+	GetHistorySince(date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetHistorySinceContext(ctx context.Context, date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetAuthorHistory(authorID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetAuthorHistoryContext(ctx context.Context, authorID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	Fail(historyID int64) error
+	FailContext(ctx context.Context, historyID int64) error
+}
+
+// ImportListAPI contains the Readarr methods for import lists.
+type ImportListAPI interface {
+	GetImportLists() ([]*ImportListOutput, error)
+	GetImportListsContext(ctx context.Context) ([]*ImportListOutput, error)
+	GetImportList(importListID int64) (*ImportListOutput, error)
+	GetImportListContext(ctx context.Context, importListID int64) (*ImportListOutput, error)
+	AddImportList(importList *ImportListInput) (*ImportListOutput, error)
+	AddImportListContext(ctx context.Context, importList *ImportListInput) (*ImportListOutput, error)
+	TestImportList(list *ImportListInput) error
+	TestImportListContextt(ctx context.Context, list *ImportListInput) error
+	UpdateImportList(importList *ImportListInput, force bool) (*ImportListOutput, error)
+	UpdateImportListContext(ctx context.Context, importList *ImportListInput, force bool) (*ImportListOutput, error)
+	DeleteImportList(importListID int64) error
+	DeleteImportListContext(ctx context.Context, importListID int64) error
+	GetImportListSchema() ([]*ImportListOutput, error)
+	GetImportListSchemaContext(ctx context.Context) ([]*ImportListOutput, error)
+	UpdateBulkImportLists(bulk *BulkImportList) ([]*ImportListOutput, error)
+	UpdateBulkImportListsContext(ctx context.Context, bulk *BulkImportList) ([]*ImportListOutput, error)
+	DeleteBulkImportLists(ids []int64) error
+	DeleteBulkImportListsContext(ctx context.Context, ids []int64) error
+	TestAllImportLists() ([]*starr.ProviderTestResult, error)
+	TestAllImportListsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// IndexerAPI contains the Readarr methods for indexers.
+type IndexerAPI interface {
+	GetIndexers() ([]*IndexerOutput, error)
+	GetIndexersContext(ctx context.Context) ([]*IndexerOutput, error)
+	GetIndexer(indexerID int64) (*IndexerOutput, error)
+	GetIndexerContext(ctx context.Context, indexerID int64) (*IndexerOutput, error)
+	TestIndexer(indexer *IndexerInput) error
+	TestIndexerContext(ctx context.Context, indexer *IndexerInput) error
+	AddIndexer(indexer *IndexerInput) (*IndexerOutput, error)
+	AddIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error)
+	UpdateIndexer(indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	UpdateIndexerContext(ctx context.Context, indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	DeleteIndexer(indexerID int64) error
+	DeleteIndexerContext(ctx context.Context, indexerID int64) error
+	GetIndexerSchema() ([]*IndexerOutput, error)
+	GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error)
+	UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error)
+	UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error)
+	DeleteBulkIndexers(ids []int64) error
+	DeleteBulkIndexersContext(ctx context.Context, ids []int64) error
+	TestAllIndexers() ([]*starr.ProviderTestResult, error)
+	TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// IndexerConfigAPI contains the Readarr methods for indexer configuration.
+type IndexerConfigAPI interface {
+	GetIndexerConfig() (*IndexerConfig, error)
+	GetIndexerConfigContext(ctx context.Context) (*IndexerConfig, error)
+	UpdateIndexerConfig(indexerConfig *IndexerConfig) (*IndexerConfig, error)
+	UpdateIndexerConfigContext(ctx context.Context, config *IndexerConfig) (*IndexerConfig, error)
+}
+
+// ManualImportAPI contains the Readarr methods for manual imports.
+type ManualImportAPI interface {
+	ManualImport(params *ManualImportParams) (*ManualImportOutput, error)
+	ManualImportContext(ctx context.Context, params *ManualImportParams) (*ManualImportOutput, error)
+	ManualImportReprocess(manualimport *ManualImportInput) error
+	ManualImportReprocessContext(ctx context.Context, manualimport *ManualImportInput) error
+}
+
+// MediaManagementAPI contains the Readarr methods for media management configuration.
+type MediaManagementAPI interface {
+	GetMediaManagement() (*MediaManagement, error)
+	GetMediaManagementContext(ctx context.Context) (*MediaManagement, error)
+	UpdateMediaManagement(mMgt *MediaManagement) (*MediaManagement, error)
+	UpdateMediaManagementContext(ctx context.Context, mMgt *MediaManagement) (*MediaManagement, error)
+}
+
+// MetadataProfileAPI contains the Readarr methods for metadata profiles.
+type MetadataProfileAPI interface {
+	GetMetadataProfiles() ([]*MetadataProfile, error)
+	GetMetadataProfilesContext(ctx context.Context) ([]*MetadataProfile, error)
+}
+
+// NamingAPI contains the Readarr methods for naming configuration.
+type NamingAPI interface {
+	GetNaming() (*Naming, error)
+	GetNamingContext(ctx context.Context) (*Naming, error)
+	UpdateNaming(naming *Naming) (*Naming, error)
+	UpdateNamingContext(ctx context.Context, naming *Naming) (*Naming, error)
+}
+
+// NotificationAPI contains the Readarr methods for notifications.
+type NotificationAPI interface {
+	GetNotifications() ([]*NotificationOutput, error)
+	GetNotificationsContext(ctx context.Context) ([]*NotificationOutput, error)
+	GetNotification(notificationID int) (*NotificationOutput, error)
+	GetNotificationContext(ctx context.Context, notificationID int) (*NotificationOutput, error)
+	AddNotification(notification *NotificationInput) (*NotificationOutput, error)
+	AddNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	UpdateNotification(notification *NotificationInput) (*NotificationOutput, error)
+	UpdateNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	DeleteNotification(notificationID int64) error
+	DeleteNotificationContext(ctx context.Context, notificationID int64) error
+	GetNotificationSchema() ([]*NotificationOutput, error)
+	GetNotificationSchemaContext(ctx context.Context) ([]*NotificationOutput, error)
+	TestAllNotifications() ([]*starr.ProviderTestResult, error)
+	TestAllNotificationsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// ParseAPI contains the Readarr methods for parsing release titles.
+type ParseAPI interface {
+	Parse(title string) (*ParseOutput, error)
+	ParseContext(ctx context.Context, title string) (*ParseOutput, error)
+}
+
+// QualityProfileAPI contains the Readarr methods for quality profiles.
+type QualityProfileAPI interface {
+	GetQualityProfiles() ([]*QualityProfile, error)
+	GetQualityProfilesContext(ctx context.Context) ([]*QualityProfile, error)
+	AddQualityProfile(profile *QualityProfile) (int64, error)
+	AddQualityProfileContext(ctx context.Context, profile *QualityProfile) (int64, error)
+	UpdateQualityProfile(profile *QualityProfile) (*QualityProfile, error)
+	UpdateQualityProfileContext(ctx context.Context, profile *QualityProfile) (*QualityProfile, error)
+	DeleteQualityProfile(profileID int64) error
+	DeleteQualityProfileContext(ctx context.Context, profileID int64) error
+}
+
+// QueueAPI contains the Readarr methods for the activity queue.
+type QueueAPI interface {
+	GetQueue(records, perPage int) (*Queue, error)
+	GetQueueContext(ctx context.Context, records, perPage int) (*Queue, error)
+	GetQueuePage(params *starr.PageReq) (*Queue, error)
+	GetQueuePageContext(ctx context.Context, params *starr.PageReq) (*Queue, error)
+	GetQueueDetails(authorID int64, bookIDs ...int64) ([]*QueueRecord, error)
+	GetQueueDetailsContext(ctx context.Context, authorID int64, bookIDs ...int64) ([]*QueueRecord, error)
+	GetQueueStatus() (*starr.QueueStatus, error)
+	GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error)
+	DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteQueueContext(ctx context.Context, queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	QueueGrab(ids ...int64) error
+	QueueGrabContext(ctx context.Context, ids ...int64) error
+}
+
+// RemotePathMappingAPI contains the Readarr methods for remote path mappings.
+type RemotePathMappingAPI interface {
+	GetRemotePathMappings() ([]*starr.RemotePathMapping, error)
+	GetRemotePathMappingsContext(ctx context.Context) ([]*starr.RemotePathMapping, error)
+	GetRemotePathMapping(mappingID int64) (*starr.RemotePathMapping, error)
+	GetRemotePathMappingContext(ctx context.Context, mappingID int64) (*starr.RemotePathMapping, error)
+	AddRemotePathMapping(mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	AddRemotePathMappingContext(ctx context.Context, mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	UpdateRemotePathMapping(mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	UpdateRemotePathMappingContext(ctx context.Context,
+		mapping *starr.RemotePathMapping,
+	) (*starr.RemotePathMapping, error)
+	DeleteRemotePathMapping(mappingID int64) error
+	DeleteRemotePathMappingContext(ctx context.Context, mappingID int64) error
+}
+
+// RenameAPI contains the Readarr methods for rename previews.
+type RenameAPI interface {
+	GetRenames(authorID, bookID int64) ([]*Rename, error)
+	GetRenamesContext(ctx context.Context, authorID, bookID int64) ([]*Rename, error)
+	GetRetags(authorID, bookID int64) ([]*Retag, error)
+	GetRetagsContext(ctx context.Context, authorID, bookID int64) ([]*Retag, error)
+}
+
+// RootFolderAPI contains the Readarr methods for root folders.
+type RootFolderAPI interface {
+	GetRootFolders() ([]*RootFolder, error)
+	GetRootFoldersContext(ctx context.Context) ([]*RootFolder, error)
+}
+
+// SystemAPI contains the Readarr methods for system status, backups, logs and other system endpoints.
+type SystemAPI interface {
+	Ping() error
+	PingContext(ctx context.Context) error
+	GetSystemStatus() (*SystemStatus, error)
+	GetSystemStatusContext(ctx context.Context) (*SystemStatus, error)
+	GetBackupFiles() ([]*starr.BackupFile, error)
+	GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error)
+	GetDiskSpace() ([]*starr.DiskSpace, error)
+	GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error)
+	GetHealth() ([]*starr.Health, error)
+	GetHealthContext(ctx context.Context) ([]*starr.Health, error)
+}
+
+// TagAPI contains the Readarr methods for tags.
+type TagAPI interface {
+	GetTags() ([]*starr.Tag, error)
+	GetTagsContext(ctx context.Context) ([]*starr.Tag, error)
+	GetTag(tagID int) (*starr.Tag, error)
+	GetTagContext(ctx context.Context, tagID int) (*starr.Tag, error)
+	AddTag(tag *starr.Tag) (*starr.Tag, error)
+	AddTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	UpdateTag(tag *starr.Tag) (*starr.Tag, error)
+	UpdateTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	DeleteTag(tagID int) error
+	DeleteTagContext(ctx context.Context, tagID int) error
+}
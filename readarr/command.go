@@ -15,9 +15,11 @@ const bpCommand = APIver + "/command"
 // CommandRequest goes into the /api/v1/command endpoint.
 // This was created from the search command and may not support other commands yet.
 type CommandRequest struct {
-	Name    string  `json:"name"`
-	BookIDs []int64 `json:"bookIds,omitempty"`
-	BookID  int64   `json:"bookId,omitempty"`
+	Name     string  `json:"name"`
+	BookIDs  []int64 `json:"bookIds,omitempty"`
+	BookID   int64   `json:"bookId,omitempty"`
+	AuthorID int64   `json:"authorId,omitempty"` // RenameFiles and RetagFiles only
+	Files    []int64 `json:"files,omitempty"`    // RenameFiles and RetagFiles only
 }
 
 // CommandResponse comes from the /api/v1/command endpoint.
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/url"
 	"path"
+	"strconv"
 	"time"
 
 	"golift.io/starr"
@@ -35,28 +37,133 @@ type HistoryRecord struct {
 	DownloadID          string         `json:"downloadId"`
 	EventType           string         `json:"eventType"`
 	Data                struct {
-		Age             string    `json:"age"`
-		AgeHours        string    `json:"ageHours"`
-		AgeMinutes      string    `json:"ageMinutes"`
-		DownloadClient  string    `json:"downloadClient"`
-		DownloadForced  string    `json:"downloadForced"`
-		DownloadURL     string    `json:"downloadUrl"`
-		DroppedPath     string    `json:"droppedPath"`
-		GUID            string    `json:"guid"`
-		ImportedPath    string    `json:"importedPath"`
-		Indexer         string    `json:"indexer"`
-		Message         string    `json:"message"`
-		NzbInfoURL      string    `json:"nzbInfoUrl"`
-		Protocol        string    `json:"protocol"`
-		PublishedDate   time.Time `json:"publishedDate"`
-		Reason          string    `json:"reason"`
-		ReleaseGroup    string    `json:"releaseGroup"`
-		Size            string    `json:"size"`
-		StatusMessages  string    `json:"statusMessages"`
-		TorrentInfoHash string    `json:"torrentInfoHash"`
+		Age                string    `json:"age"`
+		AgeHours           string    `json:"ageHours"`
+		AgeMinutes         string    `json:"ageMinutes"`
+		DownloadClient     string    `json:"downloadClient"`
+		DownloadClientName string    `json:"downloadClientName"`
+		DownloadForced     string    `json:"downloadForced"`
+		DownloadURL        string    `json:"downloadUrl"`
+		DroppedPath        string    `json:"droppedPath"`
+		GUID               string    `json:"guid"`
+		ImportedPath       string    `json:"importedPath"`
+		Indexer            string    `json:"indexer"`
+		Message            string    `json:"message"`
+		NzbInfoURL         string    `json:"nzbInfoUrl"`
+		Path               string    `json:"path"`
+		Protocol           string    `json:"protocol"`
+		PublishedDate      time.Time `json:"publishedDate"`
+		Reason             string    `json:"reason"`
+		RelativePath       string    `json:"relativePath"`
+		ReleaseGroup       string    `json:"releaseGroup"`
+		Size               string    `json:"size"`
+		SourcePath         string    `json:"sourcePath"`
+		SourceRelativePath string    `json:"sourceRelativePath"`
+		StatusMessages     string    `json:"statusMessages"`
+		TorrentInfoHash    string    `json:"torrentInfoHash"`
 	} `json:"data"`
 }
 
+// historyEvents maps the event types in a history record to their filters.
+var historyEvents = map[string]starr.Filtering{ //nolint:gochecknoglobals
+	"grabbed":              FilterGrabbed,
+	"bookFileImported":     FilterBookFileImported,
+	"downloadFailed":       FilterDownloadFailed,
+	"bookFileDeleted":      FilterDeleted,
+	"bookFileRenamed":      FilterRenamed,
+	"bookImportIncomplete": FilterImportFailed,
+	"downloadImported":     FilterDownloadImported,
+	"bookFileRetagged":     FilterRetagged,
+	"downloadIgnored":      FilterIgnored,
+}
+
+// Filter returns the filter for the record's event type, ie. FilterGrabbed.
+// Returns FilterAll if the event type is not known.
+func (h *HistoryRecord) Filter() starr.Filtering {
+	return historyEvents[h.EventType]
+}
+
+// Grabbed returns the data for a grabbed event, or nil if the record is another event type.
+func (h *HistoryRecord) Grabbed() *starr.GrabbedData {
+	if h.Filter() != FilterGrabbed {
+		return nil
+	}
+
+	size, _ := strconv.ParseInt(h.Data.Size, 10, 64) //nolint:gomnd
+	age, _ := strconv.ParseInt(h.Data.Age, 10, 64)   //nolint:gomnd
+
+	return &starr.GrabbedData{
+		Indexer:            h.Data.Indexer,
+		ReleaseGroup:       h.Data.ReleaseGroup,
+		Size:               size,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+		DownloadURL:        h.Data.DownloadURL,
+		GUID:               h.Data.GUID,
+		Protocol:           h.Data.Protocol,
+		PublishedDate:      h.Data.PublishedDate,
+		Age:                age,
+		NzbInfoURL:         h.Data.NzbInfoURL,
+		TorrentInfoHash:    h.Data.TorrentInfoHash,
+	}
+}
+
+// Imported returns the data for an imported event, or nil if the record is another event type.
+func (h *HistoryRecord) Imported() *starr.ImportedData {
+	if h.Filter() != FilterBookFileImported {
+		return nil
+	}
+
+	return &starr.ImportedData{
+		DroppedPath:        h.Data.DroppedPath,
+		ImportedPath:       h.Data.ImportedPath,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+	}
+}
+
+// Failed returns the data for a failed download event, or nil if the record is another event type.
+func (h *HistoryRecord) Failed() *starr.FailedData {
+	if h.Filter() != FilterDownloadFailed {
+		return nil
+	}
+
+	size, _ := strconv.ParseInt(h.Data.Size, 10, 64) //nolint:gomnd
+
+	return &starr.FailedData{
+		Message:            h.Data.Message,
+		Indexer:            h.Data.Indexer,
+		ReleaseGroup:       h.Data.ReleaseGroup,
+		Size:               size,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+		Protocol:           h.Data.Protocol,
+	}
+}
+
+// Deleted returns the data for a deleted file event, or nil if the record is another event type.
+func (h *HistoryRecord) Deleted() *starr.DeletedData {
+	if h.Filter() != FilterDeleted {
+		return nil
+	}
+
+	return &starr.DeletedData{Reason: h.Data.Reason}
+}
+
+// Renamed returns the data for a renamed file event, or nil if the record is another event type.
+func (h *HistoryRecord) Renamed() *starr.RenamedData {
+	if h.Filter() != FilterRenamed {
+		return nil
+	}
+
+	return &starr.RenamedData{
+		SourcePath:         h.Data.SourcePath,
+		SourceRelativePath: h.Data.SourceRelativePath,
+		Path:               h.Data.Path,
+		RelativePath:       h.Data.RelativePath,
+	}
+}
+
 // GetHistory returns the Readarr History (grabs/failures/completed).
 // If you need control over the page, use readarr.GetHistoryPage().
 // This function simply returns the number of history records desired,
@@ -117,6 +224,58 @@ func (r *Readarr) GetHistoryPageContext(ctx context.Context, params *starr.PageR
 	return &output, nil
 }
 
+// GetHistorySince returns all of the history records since a date.
+// Provide a filter, ie. FilterGrabbed, to return only that event type, or zero for all of them.
+func (r *Readarr) GetHistorySince(date time.Time, filter starr.Filtering) ([]*HistoryRecord, error) {
+	return r.GetHistorySinceContext(context.Background(), date, filter)
+}
+
+// GetHistorySinceContext returns all of the history records since a date.
+func (r *Readarr) GetHistorySinceContext(ctx context.Context,
+	date time.Time, filter starr.Filtering,
+) ([]*HistoryRecord, error) {
+	var output []*HistoryRecord
+
+	req := starr.Request{URI: path.Join(bpHistory, "since"), Query: make(url.Values)}
+	req.Query.Set("date", date.UTC().Format(time.RFC3339))
+
+	if filter > 0 {
+		req.Query.Set("eventType", filter.Param())
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetAuthorHistory returns all of the history records for a single author.
+// Provide a filter, ie. FilterGrabbed, to return only that event type, or zero for all of them.
+func (r *Readarr) GetAuthorHistory(authorID int64, filter starr.Filtering) ([]*HistoryRecord, error) {
+	return r.GetAuthorHistoryContext(context.Background(), authorID, filter)
+}
+
+// GetAuthorHistoryContext returns all of the history records for a single author.
+func (r *Readarr) GetAuthorHistoryContext(ctx context.Context,
+	authorID int64, filter starr.Filtering,
+) ([]*HistoryRecord, error) {
+	var output []*HistoryRecord
+
+	req := starr.Request{URI: path.Join(bpHistory, "author"), Query: make(url.Values)}
+	req.Query.Set("authorId", starr.Itoa(authorID))
+
+	if filter > 0 {
+		req.Query.Set("eventType", filter.Param())
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
 // Fail marks the given history item as failed by id.
 func (r *Readarr) Fail(historyID int64) error {
 	return r.FailContext(context.Background(), historyID)
@@ -0,0 +1,122 @@
+package readarr_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestHistoryRecordData(t *testing.T) {
+	t.Parallel()
+
+	var records []*readarr.HistoryRecord
+
+	err := json.Unmarshal([]byte(`[
+		{"eventType": "grabbed", "data": {"indexer": "Indexer", "size": "1024", "downloadClient": "SABnzbd"}},
+		{"eventType": "bookFileImported", "data": {"droppedPath": "/downloads/a.epub", "importedPath": "/books/a.epub"}},
+		{"eventType": "downloadFailed", "data": {"message": "Download failed"}},
+		{"eventType": "bookFileRenamed", "data": {"sourcePath": "/books/b.epub", "path": "/books/c.epub"}},
+		{"eventType": "somethingNew", "data": {}}
+	]`), &records)
+	require.NoError(t, err)
+
+	assert.Equal(t, readarr.FilterGrabbed, records[0].Filter())
+	assert.Equal(t, "Indexer", records[0].Grabbed().Indexer)
+	assert.EqualValues(t, 1024, records[0].Grabbed().Size)
+	assert.Nil(t, records[0].Imported())
+
+	assert.Equal(t, readarr.FilterBookFileImported, records[1].Filter())
+	assert.Equal(t, "/books/a.epub", records[1].Imported().ImportedPath)
+
+	assert.Equal(t, readarr.FilterDownloadFailed, records[2].Filter())
+	assert.Equal(t, "Download failed", records[2].Failed().Message)
+
+	assert.Equal(t, readarr.FilterRenamed, records[3].Filter())
+	assert.Equal(t, &starr.RenamedData{SourcePath: "/books/b.epub", Path: "/books/c.epub"}, records[3].Renamed())
+
+	assert.Equal(t, readarr.FilterAll, records[4].Filter())
+	assert.Nil(t, records[4].Grabbed())
+}
+
+func TestGetHistorySince(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("test", -3600))
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "history/since?date=2023-01-02T04%3A04%3A05Z&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "authorId": 7, "bookId": 2, "eventType": "bookFileImported"}]`,
+			WithResponse:   []*readarr.HistoryRecord{{ID: 1, AuthorID: 7, BookID: 2, EventType: "bookFileImported"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "history/since?date=2023-01-02T04%3A04%3A05Z&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*readarr.HistoryRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHistorySince(date, readarr.FilterBookFileImported)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetAuthorHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "history/author?authorId=7&eventType=1"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "authorId": 7, "bookId": 2, "eventType": "grabbed"}]`,
+			WithResponse:   []*readarr.HistoryRecord{{ID: 1, AuthorID: 7, BookID: 2, EventType: "grabbed"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "history/author?authorId=7&eventType=1"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*readarr.HistoryRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetAuthorHistory(7, readarr.FilterGrabbed)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
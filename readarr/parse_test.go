@@ -0,0 +1,93 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+const parseBody = `{
+	"title": "Author Name - Book Title (2020) [EPUB]",
+	"parsedBookInfo": {
+		"releaseTitle": "Author Name - Book Title (2020) [EPUB]",
+		"bookTitle": "Book Title",
+		"authorName": "Author Name",
+		"authorTitleInfo": {"title": "Author Name", "titleWithoutYear": "Author Name", "year": 0},
+		"quality": {
+			"quality": {"id": 3, "name": "EPUB"},
+			"revision": {"version": 1, "real": 0, "isRepack": false}
+		},
+		"releaseDate": "2020",
+		"discography": false,
+		"discographyStart": 0,
+		"discographyEnd": 0,
+		"releaseVersion": ""
+	},
+	"author": {"id": 2, "authorName": "Author Name"},
+	"books": [{"id": 5, "title": "Book Title", "authorId": 2}]
+}`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	const title = "Author Name - Book Title (2020) [EPUB]"
+
+	expectedPath := path.Join("/", starr.API, readarr.APIver,
+		"parse?title=Author+Name+-+Book+Title+%282020%29+%5BEPUB%5D")
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   expectedPath,
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   parseBody,
+			WithResponse: &readarr.ParseOutput{
+				Title: title,
+				ParsedBookInfo: &readarr.ParsedBookInfo{
+					ReleaseTitle: title,
+					BookTitle:    "Book Title",
+					AuthorName:   "Author Name",
+					AuthorTitleInfo: &readarr.AuthorTitleInfo{
+						Title:            "Author Name",
+						TitleWithoutYear: "Author Name",
+					},
+					Quality: &starr.Quality{
+						Quality:  &starr.BaseQuality{ID: 3, Name: "EPUB"},
+						Revision: &starr.QualityRevision{Version: 1},
+					},
+					ReleaseDate: "2020",
+				},
+				Author: &readarr.Author{ID: 2, AuthorName: "Author Name"},
+				Books:  []*readarr.Book{{ID: 5, Title: "Book Title", AuthorID: 2}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   expectedPath,
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*readarr.ParseOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Parse(title)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
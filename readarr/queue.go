@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
@@ -111,6 +112,54 @@ func (r *Readarr) GetQueuePageContext(ctx context.Context, params *starr.PageReq
 	return &output, nil
 }
 
+// GetQueueDetails returns every record in the Activity Queue without paging.
+// Provide a author ID to return only the records for that author, and book IDs to narrow it
+// down further. A zero author ID returns all of them, including items that are not matched to one.
+func (r *Readarr) GetQueueDetails(authorID int64, bookIDs ...int64) ([]*QueueRecord, error) {
+	return r.GetQueueDetailsContext(context.Background(), authorID, bookIDs...)
+}
+
+// GetQueueDetailsContext returns every record in the Activity Queue without paging.
+func (r *Readarr) GetQueueDetailsContext(ctx context.Context,
+	authorID int64, bookIDs ...int64,
+) ([]*QueueRecord, error) {
+	var output []*QueueRecord
+
+	req := starr.Request{URI: path.Join(bpQueue, "details"), Query: make(url.Values)}
+	req.Query.Set("includeUnknownAuthorItems", "true")
+
+	if authorID > 0 {
+		req.Query.Set("authorId", starr.Itoa(authorID))
+	}
+
+	for _, id := range bookIDs {
+		req.Query.Add("bookIds", starr.Itoa(id))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetQueueStatus returns the number of records in the Activity Queue, and if any of them have errors or warnings.
+func (r *Readarr) GetQueueStatus() (*starr.QueueStatus, error) {
+	return r.GetQueueStatusContext(context.Background())
+}
+
+// GetQueueStatusContext returns the number of records in the Activity Queue, and if any have errors or warnings.
+func (r *Readarr) GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error) {
+	var output starr.QueueStatus
+
+	req := starr.Request{URI: path.Join(bpQueue, "status")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
 // DeleteQueue deletes an item from the Activity Queue.
 func (r *Readarr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
 	return r.DeleteQueueContext(context.Background(), queueID, opts)
@@ -126,6 +175,31 @@ func (r *Readarr) DeleteQueueContext(ctx context.Context, queueID int64, opts *s
 	return nil
 }
 
+// DeleteBulkQueue deletes many items from the Activity Queue at once.
+// The delete options are applied to every item.
+func (r *Readarr) DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	return r.DeleteBulkQueueContext(context.Background(), queueIDs, opts)
+}
+
+// DeleteBulkQueueContext deletes many items from the Activity Queue at once.
+func (r *Readarr) DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: queueIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpQueue, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpQueue, "bulk"), Query: opts.Values(), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
 // QueueGrab tells the app to grab an item that's in queue.
 // Most often used on items with a delay set from a delay profile.
 func (r *Readarr) QueueGrab(ids ...int64) error {
@@ -0,0 +1,93 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const (
+	bpRename = APIver + "/rename"
+	bpRetag  = APIver + "/retag"
+)
+
+// Rename is the /api/v1/rename endpoint.
+// This is a preview of the changes the RenameFiles command will make.
+type Rename struct {
+	AuthorID     int64  `json:"authorId"`
+	BookID       int64  `json:"bookId"`
+	BookFileID   int64  `json:"bookFileId"`
+	ExistingPath string `json:"existingPath"`
+	NewPath      string `json:"newPath"`
+}
+
+// Retag is the /api/v1/retag endpoint.
+// This is a preview of the changes the RetagFiles command will make.
+type Retag struct {
+	AuthorID     int64          `json:"authorId"`
+	BookID       int64          `json:"bookId"`
+	TrackNumbers []int          `json:"trackNumbers"`
+	BookFileID   int64          `json:"bookFileId"`
+	Path         string         `json:"path"`
+	Changes      []*RetagChange `json:"changes"`
+}
+
+// RetagChange is part of Retag, and describes a single tag change.
+type RetagChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// GetRenames returns the files that would be renamed for an author.
+// Set bookID to 0 to preview every book.
+// Pass the BookFileID values to SendCommand with a RenameFiles command to apply the changes.
+func (r *Readarr) GetRenames(authorID, bookID int64) ([]*Rename, error) {
+	return r.GetRenamesContext(context.Background(), authorID, bookID)
+}
+
+// GetRenamesContext returns the files that would be renamed for an author.
+// Set bookID to 0 to preview every book.
+func (r *Readarr) GetRenamesContext(ctx context.Context, authorID, bookID int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: renameParams(authorID, bookID)}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetRetags returns the files that would have their tags updated for an author.
+// Set bookID to 0 to preview every book.
+// Pass the BookFileID values to SendCommand with a RetagFiles command to apply the changes.
+func (r *Readarr) GetRetags(authorID, bookID int64) ([]*Retag, error) {
+	return r.GetRetagsContext(context.Background(), authorID, bookID)
+}
+
+// GetRetagsContext returns the files that would have their tags updated for an author.
+// Set bookID to 0 to preview every book.
+func (r *Readarr) GetRetagsContext(ctx context.Context, authorID, bookID int64) ([]*Retag, error) {
+	var output []*Retag
+
+	req := starr.Request{URI: bpRetag, Query: renameParams(authorID, bookID)}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+func renameParams(authorID, bookID int64) url.Values {
+	params := make(url.Values)
+	params.Set("authorId", starr.Itoa(authorID))
+
+	if bookID > 0 {
+		params.Set("bookId", starr.Itoa(bookID))
+	}
+
+	return params
+}
@@ -9,7 +9,11 @@ import (
 	"golift.io/starr"
 )
 
-const bpSystem = APIver + "/system"
+const (
+	bpSystem    = APIver + "/system"
+	bpDiskSpace = APIver + "/diskspace"
+	bpHealth    = APIver + "/health"
+)
 
 // SystemStatus is the /api/v1/system/status endpoint.
 type SystemStatus struct {
@@ -81,3 +85,37 @@ func (r *Readarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFil
 
 	return output, nil
 }
+
+// GetDiskSpace returns the free and total space for every disk Readarr uses.
+func (r *Readarr) GetDiskSpace() ([]*starr.DiskSpace, error) {
+	return r.GetDiskSpaceContext(context.Background())
+}
+
+// GetDiskSpaceContext returns the free and total space for every disk Readarr uses.
+func (r *Readarr) GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error) {
+	var output []*starr.DiskSpace
+
+	req := starr.Request{URI: bpDiskSpace}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetHealth returns the health check warnings and errors from Readarr.
+func (r *Readarr) GetHealth() ([]*starr.Health, error) {
+	return r.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns the health check warnings and errors from Readarr.
+func (r *Readarr) GetHealthContext(ctx context.Context) ([]*starr.Health, error) {
+	var output []*starr.Health
+
+	req := starr.Request{URI: bpHealth}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,122 @@
+package starr
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+/* This file contains helpers for the /{provider}/schema endpoints.
+ * Every app has these for indexers, download clients, notifications, import lists, etc.
+ * The schema contains every implementation, and the fields each one accepts.
+ */
+
+// Errors returned by FieldsFromSchema.
+var (
+	// ErrUnknownField is returned when a value is provided for a field that is not in the schema.
+	ErrUnknownField = errors.New("field is not in schema")
+	// ErrMissingField is returned when a required field has no value and no schema default.
+	ErrMissingField = errors.New("required field has no value")
+	// ErrInvalidOption is returned when a select field value does not match any of its select options.
+	ErrInvalidOption = errors.New("value is not a valid select option")
+)
+
+// FieldsFromSchema turns a list of schema fields and a map of field names to values into a
+// list of field inputs. This is used to build the Input types for providers from a schema entry.
+// Fields without a provided value use the schema default. An error is returned if a value is
+// provided for a field that does not exist, if a select field value is not a valid option,
+// or if a required field has no value. The error wraps ErrRequestError and every problem found;
+// use errors.Is with ErrUnknownField, ErrMissingField and ErrInvalidOption.
+//
+// Starr apps do not mark fields as required, so this procedure assumes a field is required if
+// it is a non-advanced and non-hidden text field with no default, and not a username or password.
+func FieldsFromSchema(schema []*FieldOutput, values map[string]interface{}) ([]*FieldInput, error) {
+	var (
+		output = make([]*FieldInput, 0, len(schema))
+		known  = make(map[string]bool, len(schema))
+		errs   []error
+	)
+
+	for _, field := range schema {
+		known[field.Name] = true
+		value, ok := values[field.Name]
+
+		switch {
+		case ok && !field.validOption(value):
+			errs = append(errs, fmt.Errorf("%s=%v: %w", field.Name, value, ErrInvalidOption))
+		case !ok && field.required():
+			errs = append(errs, fmt.Errorf("%s: %w", field.Name, ErrMissingField))
+		case !ok:
+			value = field.Value
+		}
+
+		output = append(output, &FieldInput{Name: field.Name, Value: value})
+	}
+
+	unknown := []string{}
+
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+
+	sort.Strings(unknown)
+
+	for _, name := range unknown {
+		errs = append(errs, fmt.Errorf("%s: %w", name, ErrUnknownField))
+	}
+
+	if len(errs) > 0 {
+		return output, fmt.Errorf("%w: %w", ErrRequestError, errors.Join(errs...))
+	}
+
+	return output, nil
+}
+
+// validOption returns true if the value is one of the field's select options,
+// or if the field has no select options.
+func (f *FieldOutput) validOption(value interface{}) bool {
+	if len(f.SelectOptions) == 0 {
+		return true
+	}
+
+	// Some select fields accept a list of options.
+	if list := reflect.ValueOf(value); list.Kind() == reflect.Slice {
+		for idx := 0; idx < list.Len(); idx++ {
+			if !f.validOption(list.Index(idx).Interface()) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, opt := range f.SelectOptions {
+		if fmt.Sprint(value) == fmt.Sprint(opt.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// required guesses if a field must have a value. See FieldsFromSchema.
+func (f *FieldOutput) required() bool {
+	if f.Advanced || f.Hidden == "hidden" || (f.Value != nil && f.Value != "") {
+		return false
+	}
+
+	switch f.Privacy {
+	case "userName", "password":
+		return false
+	}
+
+	switch f.Type {
+	case "textbox", "url", "password", "path":
+		return true
+	default:
+		return false
+	}
+}
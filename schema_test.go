@@ -0,0 +1,57 @@
+package starr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func testSchemaFields() []*starr.FieldOutput {
+	return []*starr.FieldOutput{
+		{Name: "baseUrl", Type: "textbox"},
+		{Name: "apiKey", Type: "textbox", Privacy: "apiKey"},
+		{Name: "username", Type: "textbox", Privacy: "userName"},
+		{Name: "apiPath", Type: "textbox", Value: "/api", Advanced: true},
+		{Name: "minimumSeeders", Type: "number", Value: float64(1)},
+		{Name: "categories", Type: "select", SelectOptions: []*starr.SelectOption{{Value: 2000}, {Value: 5000}}},
+	}
+}
+
+func TestFieldsFromSchema(t *testing.T) {
+	t.Parallel()
+
+	fields, err := starr.FieldsFromSchema(testSchemaFields(), map[string]interface{}{
+		"baseUrl":    "https://indexer",
+		"apiKey":     "abc123",
+		"categories": []int{2000, 5000},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*starr.FieldInput{
+		{Name: "baseUrl", Value: "https://indexer"},
+		{Name: "apiKey", Value: "abc123"},
+		{Name: "username"},
+		{Name: "apiPath", Value: "/api"},
+		{Name: "minimumSeeders", Value: float64(1)},
+		{Name: "categories", Value: []int{2000, 5000}},
+	}, fields)
+}
+
+func TestFieldsFromSchemaErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := starr.FieldsFromSchema(testSchemaFields(), map[string]interface{}{
+		"baseUrl":    "https://indexer",
+		"categories": 1234,
+		"nope":       true,
+	})
+	require.ErrorIs(t, err, starr.ErrRequestError)
+	require.ErrorIs(t, err, starr.ErrMissingField)
+	require.ErrorIs(t, err, starr.ErrInvalidOption)
+	require.ErrorIs(t, err, starr.ErrUnknownField)
+	assert.ErrorContains(t, err, "apiKey: "+starr.ErrMissingField.Error())
+	assert.ErrorContains(t, err, "categories=1234: "+starr.ErrInvalidOption.Error())
+	assert.ErrorContains(t, err, "nope: "+starr.ErrUnknownField.Error())
+	assert.NotContains(t, err.Error(), "username", "username fields are never required")
+}
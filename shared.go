@@ -3,6 +3,7 @@ package starr
 import (
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -42,21 +43,45 @@ func (a App) Lower() string {
 }
 
 // Client returns the default client, and is used if one is not passed in.
-func Client(timeout time.Duration, verifySSL bool) *http.Client {
-	return &http.Client{
+// Options are applied in order. See ClientOption for TLS and proxy options.
+// If an option returns an error, every request made with the client returns that error.
+// Use NewClient to check the options when the client is created.
+func Client(timeout time.Duration, verifySSL bool, opts ...ClientOption) *http.Client {
+	client, err := NewClient(timeout, verifySSL, opts...)
+	if err != nil {
+		client.Transport = &optionError{err: err}
+	}
+
+	return client
+}
+
+// NewClient returns the default client, like Client, and the first error returned by an option.
+// The client is not nil, even when an error is returned.
+func NewClient(timeout time.Duration, verifySSL bool, opts ...ClientOption) (*http.Client, error) {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: !verifySSL}, //nolint:gosec
+	}
+
+	client := &http.Client{
 		Timeout: timeout,
 		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
 			return http.ErrUseLastResponse
 		},
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: !verifySSL}, //nolint:gosec
-		},
+		Transport: transport,
+	}
+
+	for _, opt := range opts {
+		if err := opt(transport); err != nil {
+			return client, err
+		}
 	}
+
+	return client, nil
 }
 
 // ClientWithDebug returns an http client with a debug logger enabled.
-func ClientWithDebug(timeout time.Duration, verifySSL bool, logConfig debuglog.Config) *http.Client {
-	client := Client(timeout, verifySSL)
+func ClientWithDebug(timeout time.Duration, verifySSL bool, logConfig debuglog.Config, opts ...ClientOption) *http.Client {
+	client := Client(timeout, verifySSL, opts...)
 	client.Transport = debuglog.NewLoggingRoundTripper(logConfig, client.Transport)
 
 	return client
@@ -186,6 +211,34 @@ type SelectOption struct {
 	Name         string `json:"name"`
 }
 
+// ProviderTestResult is returned by the testall endpoints for indexers, download clients, import lists, etc.
+type ProviderTestResult struct {
+	ID                 int64            `json:"id"`
+	IsValid            bool             `json:"isValid"`
+	ValidationFailures ValidationErrors `json:"validationFailures"`
+}
+
+// ProviderTestResults turns the error from a testall request into test results.
+// Starr apps reply with a 400 when any provider fails a test, and the body contains every result.
+// If the error does not contain test results, it is returned.
+// This is used by the TestAll methods in the starr app packages.
+func ProviderTestResults(err error) ([]*ProviderTestResult, error) {
+	var (
+		reqErr *ReqError
+		output []*ProviderTestResult
+	)
+
+	if !errors.As(err, &reqErr) || reqErr.Code != http.StatusBadRequest {
+		return nil, err
+	}
+
+	if jsonErr := json.Unmarshal(reqErr.Body, &output); jsonErr != nil || len(output) == 0 {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // KeyValue is yet another reusable generic type.
 type KeyValue struct {
 	Key   string `json:"key"`
@@ -202,6 +255,23 @@ type BackupFile struct {
 	Size int64     `json:"size"`
 }
 
+// DiskSpace comes from the diskspace path in all apps, except Prowlarr.
+type DiskSpace struct {
+	Path       string `json:"path"`
+	Label      string `json:"label"`
+	FreeSpace  int64  `json:"freeSpace"`
+	TotalSpace int64  `json:"totalSpace"`
+}
+
+// Health comes from the health path in all apps.
+// These are the warnings and errors displayed on the System Status page.
+type Health struct {
+	Source  string `json:"source"`
+	Type    string `json:"type"` // ok, notice, warning or error.
+	Message string `json:"message"`
+	WikiURL string `json:"wikiUrl"`
+}
+
 // QueueDeleteOpts are the extra inputs when deleting an item from the Activity Queue.
 // Set these appropriately for your expectations. All inputs are the same in all apps.
 // Providing this input to the QueueDelete methods is optional; nil sets the defaults shown.
@@ -236,6 +306,18 @@ func (o *QueueDeleteOpts) Values() url.Values {
 	return params
 }
 
+// QueueStatus is the summary of the Activity Queue from the queue/status endpoint. All apps return the same data.
+type QueueStatus struct {
+	ID              int64 `json:"id"`
+	TotalCount      int   `json:"totalCount"`
+	Count           int   `json:"count"`
+	UnknownCount    int   `json:"unknownCount"`
+	Errors          bool  `json:"errors"`
+	Warnings        bool  `json:"warnings"`
+	UnknownErrors   bool  `json:"unknownErrors"`
+	UnknownWarnings bool  `json:"unknownWarnings"`
+}
+
 // PlayTime is used in at least Sonarr, maybe other places.
 // Holds a string duration converted from hh:mm:ss.
 type PlayTime struct {
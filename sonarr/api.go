@@ -0,0 +1,457 @@
+package sonarr
+
+import (
+	"context"
+	"time"
+
+	"golift.io/starr"
+)
+
+/* This file contains interfaces for the Sonarr methods, grouped by resource.
+ * Depend on one of these, or on API, instead of *Sonarr to swap in a fake during tests.
+ * The starrtest/mocks package has a ready-made Sonarr mock that satisfies API.
+ */
+
+// API contains every Sonarr method, except the methods from the embedded starr.APIer.
+type API interface {
+	BlocklistAPI
+	CalendarAPI
+	CommandAPI
+	CustomFormatAPI
+	DelayProfileAPI
+	DownloadClientAPI
+	DownloadClientConfigAPI
+	EpisodeAPI
+	EpisodeFileAPI
+	ExclusionAPI
+	HistoryAPI
+	ImportListAPI
+	IndexerAPI
+	IndexerConfigAPI
+	LanguageProfileAPI
+	ManualImportAPI
+	MediaManagementAPI
+	NamingAPI
+	NotificationAPI
+	ParseAPI
+	QualityDefinitionAPI
+	QualityProfileAPI
+	QueueAPI
+	ReleaseProfileAPI
+	RemotePathMappingAPI
+	RenameAPI
+	RootFolderAPI
+	SeasonPassAPI
+	SeriesAPI
+	SystemAPI
+	TagAPI
+}
+
+// Sonarr must satisfy the API interface.
+var _ API = (*Sonarr)(nil)
+
+// BlocklistAPI contains the Sonarr methods for the blocklist.
+type BlocklistAPI interface {
+	GetBlockList(count int) (*BlockList, error)
+	GetBlockListContext(ctx context.Context, records int) (*BlockList, error)
+	GetBlockListPage(params *starr.PageReq) (*BlockList, error)
+	GetBlockListPageContext(ctx context.Context, params *starr.PageReq) (*BlockList, error)
+	DeleteBlockList(listID int64) error
+	DeleteBlockListContext(ctx context.Context, listID int64) error
+	DeleteBlockLists(ids []int64) error
+	DeleteBlockListsContext(ctx context.Context, ids []int64) error
+}
+
+// CalendarAPI contains the Sonarr methods for the calendar.
+type CalendarAPI interface {
+	GetCalendar(filter Calendar) ([]*Episode, error)
+	GetCalendarContext(ctx context.Context, filter Calendar) ([]*Episode, error)
+	GetCalendarID(calendarID int64) (*Episode, error)
+	GetCalendarIDContext(ctx context.Context, calendarID int64) (*Episode, error)
+}
+
+// CommandAPI contains the Sonarr methods for commands.
+type CommandAPI interface {
+	GetCommands() ([]*CommandResponse, error)
+	GetCommandsContext(ctx context.Context) ([]*CommandResponse, error)
+	SendCommand(cmd *CommandRequest) (*CommandResponse, error)
+	SendCommandContext(ctx context.Context, cmd *CommandRequest) (*CommandResponse, error)
+	GetCommandStatus(commandID int64) (*CommandResponse, error)
+	GetCommandStatusContext(ctx context.Context, commandID int64) (*CommandResponse, error)
+}
+
+// CustomFormatAPI contains the Sonarr methods for custom formats.
+type CustomFormatAPI interface {
+	GetCustomFormats() ([]*CustomFormatOutput, error)
+	GetCustomFormatsContext(ctx context.Context) ([]*CustomFormatOutput, error)
+	GetCustomFormat(customformatID int64) (*CustomFormatOutput, error)
+	GetCustomFormatContext(ctx context.Context, customformatID int64) (*CustomFormatOutput, error)
+	AddCustomFormat(format *CustomFormatInput) (*CustomFormatOutput, error)
+	AddCustomFormatContext(ctx context.Context, format *CustomFormatInput) (*CustomFormatOutput, error)
+	UpdateCustomFormat(format *CustomFormatInput) (*CustomFormatOutput, error)
+	UpdateCustomFormatContext(ctx context.Context, format *CustomFormatInput) (*CustomFormatOutput, error)
+	DeleteCustomFormat(formatID int64) error
+	DeleteCustomFormatContext(ctx context.Context, formatID int64) error
+}
+
+// DelayProfileAPI contains the Sonarr methods for delay profiles.
+type DelayProfileAPI interface {
+	GetDelayProfiles() ([]*DelayProfile, error)
+	GetDelayProfilesContext(ctx context.Context) ([]*DelayProfile, error)
+	GetDelayProfile(profileID int64) (*DelayProfile, error)
+	GetDelayProfileContext(ctx context.Context, profileID int64) (*DelayProfile, error)
+	AddDelayProfile(profile *DelayProfile) (*DelayProfile, error)
+	AddDelayProfileContext(ctx context.Context, profile *DelayProfile) (*DelayProfile, error)
+	UpdateDelayProfile(profile *DelayProfile) (*DelayProfile, error)
+	UpdateDelayProfileContext(ctx context.Context, profile *DelayProfile) (*DelayProfile, error)
+	DeleteDelayProfile(profileID int64) error
+	DeleteDelayProfileContext(ctx context.Context, profileID int64) error
+}
+
+// DownloadClientAPI contains the Sonarr methods for download clients.
+type DownloadClientAPI interface {
+	GetDownloadClients() ([]*DownloadClientOutput, error)
+	GetDownloadClientsContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	GetDownloadClient(downloadclientID int64) (*DownloadClientOutput, error)
+	GetDownloadClientContext(ctx context.Context, downloadclientID int64) (*DownloadClientOutput, error)
+	AddDownloadClient(downloadclient *DownloadClientInput) (*DownloadClientOutput, error)
+	AddDownloadClientContext(ctx context.Context, client *DownloadClientInput) (*DownloadClientOutput, error)
+	TestDownloadClient(client *DownloadClientInput) error
+	TestDownloadClientContext(ctx context.Context, client *DownloadClientInput) error
+	UpdateDownloadClient(downloadclient *DownloadClientInput, force bool) (*DownloadClientOutput, error)
+	UpdateDownloadClientContext(ctx context.Context,
+		client *DownloadClientInput,
+		force bool,
+	) (*DownloadClientOutput, error)
+	DeleteDownloadClient(downloadclientID int64) error
+	DeleteDownloadClientContext(ctx context.Context, downloadclientID int64) error
+	GetDownloadClientSchema() ([]*DownloadClientOutput, error)
+	GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClients(bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClientsContext(ctx context.Context, bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	DeleteBulkDownloadClients(ids []int64) error
+	DeleteBulkDownloadClientsContext(ctx context.Context, ids []int64) error
+	TestAllDownloadClients() ([]*starr.ProviderTestResult, error)
+	TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// DownloadClientConfigAPI contains the Sonarr methods for download client configuration.
+type DownloadClientConfigAPI interface {
+	GetDownloadClientConfig() (*DownloadClientConfig, error)
+	GetDownloadClientConfigContext(ctx context.Context) (*DownloadClientConfig, error)
+	UpdateDownloadClientConfig(downloadClientConfig *DownloadClientConfig) (*DownloadClientConfig, error)
+	UpdateDownloadClientConfigContext(ctx context.Context, config *DownloadClientConfig) (*DownloadClientConfig, error)
+}
+
+// EpisodeAPI contains the Sonarr methods for episodes.
+type EpisodeAPI interface {
+	GetSeriesEpisodes(getEpisode *GetEpisode) ([]*Episode, error)
+	GetSeriesEpisodesContext(ctx context.Context, getEpisode *GetEpisode) ([]*Episode, error)
+	GetEpisodeByID(episodeID int64) (*Episode, error)
+	GetEpisodeByIDContext(ctx context.Context, episodeID int64) (*Episode, error)
+	MonitorEpisode(episodeIDs []int64, monitor bool) ([]*Episode, error)
+	MonitorEpisodeContext(ctx context.Context, episodeIDs []int64, monitor bool) ([]*Episode, error)
+}
+
+// EpisodeFileAPI contains the Sonarr methods for episode files.
+type EpisodeFileAPI interface {
+	GetEpisodeFiles(episodeFileIDs ...int64) ([]*EpisodeFile, error)
+	GetEpisodeFilesContext(ctx context.Context, episodeFileIDs ...int64) ([]*EpisodeFile, error)
+	GetSeriesEpisodeFiles(seriesID int64) ([]*EpisodeFile, error)
+	GetSeriesEpisodeFilesContext(ctx context.Context, seriesID int64) ([]*EpisodeFile, error)
+	UpdateEpisodeFileQuality(episodeFileID, qualityID int64) (*EpisodeFile, error)
+	UpdateEpisodeFileQualityContext(ctx context.Context, episodeFileID int64, qualityID int64) (*EpisodeFile, error)
+	DeleteEpisodeFile(episodeFileID int64) error
+	DeleteEpisodeFileContext(ctx context.Context, episodeFileID int64) error
+}
+
+// ExclusionAPI contains the Sonarr methods for exclusions.
+type ExclusionAPI interface {
+	GetExclusions() ([]*Exclusion, error)
+	GetExclusionsContext(ctx context.Context) ([]*Exclusion, error)
+	UpdateExclusion(exclusion *Exclusion) (*Exclusion, error)
+	UpdateExclusionContext(ctx context.Context, exclusion *Exclusion) (*Exclusion, error)
+	DeleteExclusions(ids []int64) error
+	DeleteExclusionsContext(ctx context.Context, ids []int64) error
+	AddExclusion(exclusion *Exclusion) (*Exclusion, error)
+	AddExclusionContext(ctx context.Context, exclusion *Exclusion) (*Exclusion, error)
+}
+
+// HistoryAPI contains the Sonarr methods for history.
+type HistoryAPI interface {
+	GetHistory(records, perPage int) (*History, error)
+	GetHistoryContext(ctx context.Context, records, perPage int) (*History, error)
+	GetHistoryPage(params *starr.PageReq) (*History, error)
+	GetHistoryPageContext(ctx context.Context, params *starr.PageReq) (*History, error)
+	GetHistorySince(date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetHistorySinceContext(ctx context.Context, date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetSeriesHistory(seriesID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetSeriesHistoryContext(ctx context.Context, seriesID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	Fail(historyID int64) error
+	FailContext(ctx context.Context, historyID int64) error
+}
+
+// ImportListAPI contains the Sonarr methods for import lists.
+type ImportListAPI interface {
+	GetImportLists() ([]*ImportListOutput, error)
+	GetImportListsContext(ctx context.Context) ([]*ImportListOutput, error)
+	GetImportList(importListID int64) (*ImportListOutput, error)
+	GetImportListContext(ctx context.Context, importListID int64) (*ImportListOutput, error)
+	AddImportList(importList *ImportListInput) (*ImportListOutput, error)
+	AddImportListContext(ctx context.Context, importList *ImportListInput) (*ImportListOutput, error)
+	TestImportList(list *ImportListInput) error
+	TestImportListContextt(ctx context.Context, list *ImportListInput) error
+	UpdateImportList(importList *ImportListInput, force bool) (*ImportListOutput, error)
+	UpdateImportListContext(ctx context.Context, importList *ImportListInput, force bool) (*ImportListOutput, error)
+	DeleteImportList(importListID int64) error
+	DeleteImportListContext(ctx context.Context, importListID int64) error
+	GetImportListSchema() ([]*ImportListOutput, error)
+	GetImportListSchemaContext(ctx context.Context) ([]*ImportListOutput, error)
+	UpdateBulkImportLists(bulk *BulkImportList) ([]*ImportListOutput, error)
+	UpdateBulkImportListsContext(ctx context.Context, bulk *BulkImportList) ([]*ImportListOutput, error)
+	DeleteBulkImportLists(ids []int64) error
+	DeleteBulkImportListsContext(ctx context.Context, ids []int64) error
+	TestAllImportLists() ([]*starr.ProviderTestResult, error)
+	TestAllImportListsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// IndexerAPI contains the Sonarr methods for indexers.
+type IndexerAPI interface {
+	GetIndexers() ([]*IndexerOutput, error)
+	GetIndexersContext(ctx context.Context) ([]*IndexerOutput, error)
+	GetIndexer(indexerID int64) (*IndexerOutput, error)
+	GetIndexerContext(ctx context.Context, indexerID int64) (*IndexerOutput, error)
+	TestIndexer(indexer *IndexerInput) error
+	TestIndexerContext(ctx context.Context, indexer *IndexerInput) error
+	AddIndexer(indexer *IndexerInput) (*IndexerOutput, error)
+	AddIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error)
+	UpdateIndexer(indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	UpdateIndexerContext(ctx context.Context, indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	DeleteIndexer(indexerID int64) error
+	DeleteIndexerContext(ctx context.Context, indexerID int64) error
+	GetIndexerSchema() ([]*IndexerOutput, error)
+	GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error)
+	UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error)
+	UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error)
+	DeleteBulkIndexers(ids []int64) error
+	DeleteBulkIndexersContext(ctx context.Context, ids []int64) error
+	TestAllIndexers() ([]*starr.ProviderTestResult, error)
+	TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// IndexerConfigAPI contains the Sonarr methods for indexer configuration.
+type IndexerConfigAPI interface {
+	GetIndexerConfig() (*IndexerConfig, error)
+	GetIndexerConfigContext(ctx context.Context) (*IndexerConfig, error)
+	UpdateIndexerConfig(indexerConfig *IndexerConfig) (*IndexerConfig, error)
+	UpdateIndexerConfigContext(ctx context.Context, indexerConfig *IndexerConfig) (*IndexerConfig, error)
+}
+
+// LanguageProfileAPI contains the Sonarr methods for language profiles.
+type LanguageProfileAPI interface {
+	GetLanguageProfiles() ([]*LanguageProfile, error)
+	GetLanguageProfilesContext(ctx context.Context) ([]*LanguageProfile, error)
+	GetLanguageProfile(profileID int64) (*LanguageProfile, error)
+	GetLanguageProfileContext(ctx context.Context, profileID int64) (*LanguageProfile, error)
+	AddLanguageProfile(profile *LanguageProfile) (*LanguageProfile, error)
+	AddLanguageProfileContext(ctx context.Context, profile *LanguageProfile) (*LanguageProfile, error)
+	UpdateLanguageProfile(profile *LanguageProfile) (*LanguageProfile, error)
+	UpdateLanguageProfileContext(ctx context.Context, profile *LanguageProfile) (*LanguageProfile, error)
+	DeleteLanguageProfile(profileID int64) error
+	DeleteLanguageProfileContext(ctx context.Context, profileID int64) error
+}
+
+// ManualImportAPI contains the Sonarr methods for manual imports.
+type ManualImportAPI interface {
+	ManualImport(params *ManualImportParams) (*ManualImportOutput, error)
+	ManualImportContext(ctx context.Context, params *ManualImportParams) (*ManualImportOutput, error)
+	ManualImportReprocess(manualimport *ManualImportInput) error
+	ManualImportReprocessContext(ctx context.Context, manualimport *ManualImportInput) error
+}
+
+// MediaManagementAPI contains the Sonarr methods for media management configuration.
+type MediaManagementAPI interface {
+	GetMediaManagement() (*MediaManagement, error)
+	GetMediaManagementContext(ctx context.Context) (*MediaManagement, error)
+	UpdateMediaManagement(mMgt *MediaManagement) (*MediaManagement, error)
+	UpdateMediaManagementContext(ctx context.Context, mMgt *MediaManagement) (*MediaManagement, error)
+}
+
+// NamingAPI contains the Sonarr methods for naming configuration.
+type NamingAPI interface {
+	GetNaming() (*Naming, error)
+	GetNamingContext(ctx context.Context) (*Naming, error)
+	UpdateNaming(naming *Naming) (*Naming, error)
+	UpdateNamingContext(ctx context.Context, naming *Naming) (*Naming, error)
+}
+
+// NotificationAPI contains the Sonarr methods for notifications.
+type NotificationAPI interface {
+	GetNotifications() ([]*NotificationOutput, error)
+	GetNotificationsContext(ctx context.Context) ([]*NotificationOutput, error)
+	GetNotification(notificationID int) (*NotificationOutput, error)
+	GetNotificationContext(ctx context.Context, notificationID int) (*NotificationOutput, error)
+	AddNotification(notification *NotificationInput) (*NotificationOutput, error)
+	AddNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	UpdateNotification(notification *NotificationInput) (*NotificationOutput, error)
+	UpdateNotificationContext(ctx context.Context, client *NotificationInput) (*NotificationOutput, error)
+	DeleteNotification(notificationID int64) error
+	DeleteNotificationContext(ctx context.Context, notificationID int64) error
+	GetNotificationSchema() ([]*NotificationOutput, error)
+	GetNotificationSchemaContext(ctx context.Context) ([]*NotificationOutput, error)
+	TestAllNotifications() ([]*starr.ProviderTestResult, error)
+	TestAllNotificationsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// ParseAPI contains the Sonarr methods for parsing release titles.
+type ParseAPI interface {
+	Parse(input *ParseInput) (*ParseOutput, error)
+	ParseContext(ctx context.Context, input *ParseInput) (*ParseOutput, error)
+}
+
+// QualityDefinitionAPI contains the Sonarr methods for quality definitions.
+type QualityDefinitionAPI interface {
+	GetQualityDefinitions() ([]*QualityDefinition, error)
+	GetQualityDefinitionsContext(ctx context.Context) ([]*QualityDefinition, error)
+	GetQualityDefinition(qualityDefinitionID int64) (*QualityDefinition, error)
+	GetQualityDefinitionContext(ctx context.Context, qdID int64) (*QualityDefinition, error)
+	UpdateQualityDefinition(definition *QualityDefinition) (*QualityDefinition, error)
+	UpdateQualityDefinitionContext(ctx context.Context, definition *QualityDefinition) (*QualityDefinition, error)
+	UpdateQualityDefinitions(definitions []*QualityDefinition) ([]*QualityDefinition, error)
+	UpdateQualityDefinitionsContext(ctx context.Context, definitions []*QualityDefinition) ([]*QualityDefinition, error)
+}
+
+// QualityProfileAPI contains the Sonarr methods for quality profiles.
+type QualityProfileAPI interface {
+	GetQualityProfiles() ([]*QualityProfile, error)
+	GetQualityProfilesContext(ctx context.Context) ([]*QualityProfile, error)
+	GetQualityProfile(profileID int64) (*QualityProfile, error)
+	GetQualityProfileContext(ctx context.Context, profileID int64) (*QualityProfile, error)
+	AddQualityProfile(profile *QualityProfile) (*QualityProfile, error)
+	AddQualityProfileContext(ctx context.Context, profile *QualityProfile) (*QualityProfile, error)
+	UpdateQualityProfile(profile *QualityProfile) (*QualityProfile, error)
+	UpdateQualityProfileContext(ctx context.Context, profile *QualityProfile) (*QualityProfile, error)
+	DeleteQualityProfile(profileID int64) error
+	DeleteQualityProfileContext(ctx context.Context, profileID int64) error
+}
+
+// QueueAPI contains the Sonarr methods for the activity queue.
+type QueueAPI interface {
+	GetQueue(records, perPage int) (*Queue, error)
+	GetQueueContext(ctx context.Context, records, perPage int) (*Queue, error)
+	GetQueuePage(params *starr.PageReq) (*Queue, error)
+	GetQueuePageContext(ctx context.Context, params *starr.PageReq) (*Queue, error)
+	GetQueueDetails(seriesID int64, episodeIDs ...int64) ([]*QueueRecord, error)
+	GetQueueDetailsContext(ctx context.Context, seriesID int64, episodeIDs ...int64) ([]*QueueRecord, error)
+	GetQueueStatus() (*starr.QueueStatus, error)
+	GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error)
+	DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteQueueContext(ctx context.Context, queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	QueueGrab(ids ...int64) error
+	QueueGrabContext(ctx context.Context, ids ...int64) error
+}
+
+// ReleaseProfileAPI contains the Sonarr methods for release profiles.
+type ReleaseProfileAPI interface {
+	GetReleaseProfiles() ([]*ReleaseProfile, error)
+	GetReleaseProfilesContext(ctx context.Context) ([]*ReleaseProfile, error)
+	GetReleaseProfile(profileID int64) (*ReleaseProfile, error)
+	GetReleaseProfileContext(ctx context.Context, profileID int64) (*ReleaseProfile, error)
+	AddReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile, error)
+	AddReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error)
+	UpdateReleaseProfile(profile *ReleaseProfile) (*ReleaseProfile, error)
+	UpdateReleaseProfileContext(ctx context.Context, profile *ReleaseProfile) (*ReleaseProfile, error)
+	DeleteReleaseProfile(profileID int64) error
+	DeleteReleaseProfileContext(ctx context.Context, profileID int64) error
+}
+
+// RemotePathMappingAPI contains the Sonarr methods for remote path mappings.
+type RemotePathMappingAPI interface {
+	GetRemotePathMappings() ([]*starr.RemotePathMapping, error)
+	GetRemotePathMappingsContext(ctx context.Context) ([]*starr.RemotePathMapping, error)
+	GetRemotePathMapping(mappingID int64) (*starr.RemotePathMapping, error)
+	GetRemotePathMappingContext(ctx context.Context, mappingID int64) (*starr.RemotePathMapping, error)
+	AddRemotePathMapping(mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	AddRemotePathMappingContext(ctx context.Context, mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	UpdateRemotePathMapping(mapping *starr.RemotePathMapping) (*starr.RemotePathMapping, error)
+	UpdateRemotePathMappingContext(ctx context.Context,
+		mapping *starr.RemotePathMapping,
+	) (*starr.RemotePathMapping, error)
+	DeleteRemotePathMapping(mappingID int64) error
+	DeleteRemotePathMappingContext(ctx context.Context, mappingID int64) error
+}
+
+// RenameAPI contains the Sonarr methods for rename previews.
+type RenameAPI interface {
+	GetRenames(seriesID int64, seasonNumber int) ([]*Rename, error)
+	GetRenamesContext(ctx context.Context, seriesID int64, seasonNumber int) ([]*Rename, error)
+}
+
+// RootFolderAPI contains the Sonarr methods for root folders.
+type RootFolderAPI interface {
+	GetRootFolders() ([]*RootFolder, error)
+	GetRootFoldersContext(ctx context.Context) ([]*RootFolder, error)
+	GetRootFolder(folderID int64) (*RootFolder, error)
+	GetRootFolderContext(ctx context.Context, folderID int64) (*RootFolder, error)
+	AddRootFolder(folder *RootFolder) (*RootFolder, error)
+	AddRootFolderContext(ctx context.Context, folder *RootFolder) (*RootFolder, error)
+	DeleteRootFolder(folderID int64) error
+	DeleteRootFolderContext(ctx context.Context, folderID int64) error
+}
+
+// SeasonPassAPI contains the Sonarr methods for the season pass.
+type SeasonPassAPI interface {
+	UpdateSeasonPass(seasonPass *SeasonPass) error
+	UpdateSeasonPassContext(ctx context.Context, seasonPass *SeasonPass) error
+}
+
+// SeriesAPI contains the Sonarr methods for series.
+type SeriesAPI interface {
+	GetAllSeries() ([]*Series, error)
+	GetAllSeriesContext(ctx context.Context) ([]*Series, error)
+	GetSeries(tvdbID int64) ([]*Series, error)
+	GetSeriesContext(ctx context.Context, tvdbID int64) ([]*Series, error)
+	UpdateSeries(series *AddSeriesInput, moveFiles bool) (*Series, error)
+	UpdateSeriesContext(ctx context.Context, series *AddSeriesInput, moveFiles bool) (*Series, error)
+	AddSeries(series *AddSeriesInput) (*Series, error)
+	AddSeriesContext(ctx context.Context, series *AddSeriesInput) (*Series, error)
+	GetSeriesByID(seriesID int64) (*Series, error)
+	GetSeriesByIDContext(ctx context.Context, seriesID int64) (*Series, error)
+	GetSeriesLookup(term string, tvdbID int64) ([]*Series, error)
+	GetSeriesLookupContext(ctx context.Context, term string, tvdbID int64) ([]*Series, error)
+	Lookup(term string) ([]*Series, error)
+	LookupContext(ctx context.Context, term string) ([]*Series, error)
+	DeleteSeries(seriesID int, deleteFiles bool, importExclude bool) error
+	DeleteSeriesContext(ctx context.Context, seriesID int, deleteFiles bool, importExclude bool) error
+	DeleteSeriesDefault(seriesID int) error
+}
+
+// SystemAPI contains the Sonarr methods for system status, backups, logs and other system endpoints.
+type SystemAPI interface {
+	Ping() error
+	PingContext(ctx context.Context) error
+	GetSystemStatus() (*SystemStatus, error)
+	GetSystemStatusContext(ctx context.Context) (*SystemStatus, error)
+	GetBackupFiles() ([]*starr.BackupFile, error)
+	GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error)
+	GetDiskSpace() ([]*starr.DiskSpace, error)
+	GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error)
+	GetHealth() ([]*starr.Health, error)
+	GetHealthContext(ctx context.Context) ([]*starr.Health, error)
+}
+
+// TagAPI contains the Sonarr methods for tags.
+type TagAPI interface {
+	GetTags() ([]*starr.Tag, error)
+	GetTagsContext(ctx context.Context) ([]*starr.Tag, error)
+	GetTag(tagID int) (*starr.Tag, error)
+	GetTagContext(ctx context.Context, tagID int) (*starr.Tag, error)
+	AddTag(tag *starr.Tag) (*starr.Tag, error)
+	AddTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	UpdateTag(tag *starr.Tag) (*starr.Tag, error)
+	UpdateTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	DeleteTag(tagID int) error
+	DeleteTagContext(ctx context.Context, tagID int) error
+}
@@ -172,3 +172,130 @@ func (s *Sonarr) DeleteDownloadClientContext(ctx context.Context, downloadclient
 
 	return nil
 }
+
+// GetDownloadClientSchema returns every download client implementation, and the fields each one accepts.
+// Use DownloadClientInputFromSchema to turn an entry into a new DownloadClientInput.
+func (s *Sonarr) GetDownloadClientSchema() ([]*DownloadClientOutput, error) {
+	return s.GetDownloadClientSchemaContext(context.Background())
+}
+
+// GetDownloadClientSchemaContext returns every download client implementation, and the fields each one accepts.
+func (s *Sonarr) GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error) {
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DownloadClientInputFromSchema builds a DownloadClientInput from a schema entry and a map of field names to values.
+// Fields that are not in the map use the schema default. See starr.FieldsFromSchema for validation details.
+// The returned input is not nil when an error is returned, so it may still be inspected.
+func DownloadClientInputFromSchema(
+	schema *DownloadClientOutput,
+	values map[string]interface{},
+) (*DownloadClientInput, error) {
+	fields, err := starr.FieldsFromSchema(schema.Fields, values)
+	input := &DownloadClientInput{
+		Enable:                   schema.Enable,
+		RemoveCompletedDownloads: schema.RemoveCompletedDownloads,
+		RemoveFailedDownloads:    schema.RemoveFailedDownloads,
+		Priority:                 schema.Priority,
+		ConfigContract:           schema.ConfigContract,
+		Implementation:           schema.Implementation,
+		Name:                     schema.Name,
+		Protocol:                 schema.Protocol,
+		Tags:                     schema.Tags,
+		Fields:                   fields,
+	}
+
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", schema.Implementation, err)
+	}
+
+	return input, nil
+}
+
+// BulkDownloadClient is the input for the bulk download client editor, UpdateBulkDownloadClients.
+// Set IDs to the download clients you want to change, and set only the members you want to update.
+// You may use starr.True(), starr.False() and starr.Int64() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for ApplyTags.
+type BulkDownloadClient struct {
+	IDs                      []int64          `json:"ids"`
+	Tags                     []int            `json:"tags,omitempty"`
+	ApplyTags                *starr.ApplyTags `json:"applyTags,omitempty"`
+	Enable                   *bool            `json:"enable,omitempty"`
+	Priority                 *int64           `json:"priority,omitempty"`
+	RemoveCompletedDownloads *bool            `json:"removeCompletedDownloads,omitempty"`
+	RemoveFailedDownloads    *bool            `json:"removeFailedDownloads,omitempty"`
+}
+
+// UpdateBulkDownloadClients updates many download clients at once. Only the non-nil members of the input are changed.
+func (s *Sonarr) UpdateBulkDownloadClients(bulk *BulkDownloadClient) ([]*DownloadClientOutput, error) {
+	return s.UpdateBulkDownloadClientsContext(context.Background(), bulk)
+}
+
+// UpdateBulkDownloadClientsContext updates many download clients at once. Only the non-nil members of the input are changed.
+func (s *Sonarr) UpdateBulkDownloadClientsContext(ctx context.Context, bulk *BulkDownloadClient) ([]*DownloadClientOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBulkDownloadClients removes many download clients at once.
+func (s *Sonarr) DeleteBulkDownloadClients(ids []int64) error {
+	return s.DeleteBulkDownloadClientsContext(context.Background(), ids)
+}
+
+// DeleteBulkDownloadClientsContext removes many download clients at once.
+func (s *Sonarr) DeleteBulkDownloadClientsContext(ctx context.Context, ids []int64) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllDownloadClients tests every configured download client, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (s *Sonarr) TestAllDownloadClients() ([]*starr.ProviderTestResult, error) {
+	return s.TestAllDownloadClientsContext(context.Background())
+}
+
+// TestAllDownloadClientsContext tests every configured download client, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (s *Sonarr) TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var output []*starr.ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "testall")}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		if output, err = starr.ProviderTestResults(err); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	}
+
+	return output, nil
+}
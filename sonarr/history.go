@@ -3,7 +3,9 @@ package sonarr
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"path"
+	"strconv"
 	"time"
 
 	"golift.io/starr"
@@ -49,18 +51,120 @@ type HistoryRecord struct {
 		Indexer            string    `json:"indexer"`
 		Message            string    `json:"message"`
 		NzbInfoURL         string    `json:"nzbInfoUrl"`
+		Path               string    `json:"path"`
 		PreferredWordScore string    `json:"preferredWordScore"`
 		Protocol           string    `json:"protocol"`
 		PublishedDate      time.Time `json:"publishedDate"`
 		Reason             string    `json:"reason"`
+		RelativePath       string    `json:"relativePath"`
 		ReleaseGroup       string    `json:"releaseGroup"`
 		Size               string    `json:"size"`
+		SourcePath         string    `json:"sourcePath"`
+		SourceRelativePath string    `json:"sourceRelativePath"`
 		TorrentInfoHash    string    `json:"torrentInfoHash"`
-		TvRageID           string    `json:"tvRageId"`
 		TvdbID             string    `json:"tvdbId"`
+		TvRageID           string    `json:"tvRageId"`
 	} `json:"data"`
 }
 
+// historyEvents maps the event types in a history record to their filters.
+var historyEvents = map[string]starr.Filtering{ //nolint:gochecknoglobals
+	"grabbed":                FilterGrabbed,
+	"seriesFolderImported":   FilterSeriesFolderImported,
+	"downloadFolderImported": FilterDownloadFolderImported,
+	"downloadFailed":         FilterDownloadFailed,
+	"episodeFileDeleted":     FilterDeleted,
+	"episodeFileRenamed":     FilterRenamed,
+	"downloadIgnored":        FilterImportFailed,
+}
+
+// Filter returns the filter for the record's event type, ie. FilterGrabbed.
+// Returns FilterUnknown if the event type is not known.
+func (h *HistoryRecord) Filter() starr.Filtering {
+	return historyEvents[h.EventType]
+}
+
+// Grabbed returns the data for a grabbed event, or nil if the record is another event type.
+func (h *HistoryRecord) Grabbed() *starr.GrabbedData {
+	if h.Filter() != FilterGrabbed {
+		return nil
+	}
+
+	size, _ := strconv.ParseInt(h.Data.Size, 10, 64) //nolint:gomnd
+	age, _ := strconv.ParseInt(h.Data.Age, 10, 64)   //nolint:gomnd
+
+	return &starr.GrabbedData{
+		Indexer:            h.Data.Indexer,
+		ReleaseGroup:       h.Data.ReleaseGroup,
+		Size:               size,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+		DownloadURL:        h.Data.DownloadURL,
+		GUID:               h.Data.GUID,
+		Protocol:           h.Data.Protocol,
+		PublishedDate:      h.Data.PublishedDate,
+		Age:                age,
+		NzbInfoURL:         h.Data.NzbInfoURL,
+		TorrentInfoHash:    h.Data.TorrentInfoHash,
+	}
+}
+
+// Imported returns the data for an imported event, or nil if the record is another event type.
+func (h *HistoryRecord) Imported() *starr.ImportedData {
+	if filter := h.Filter(); filter != FilterSeriesFolderImported && filter != FilterDownloadFolderImported {
+		return nil
+	}
+
+	return &starr.ImportedData{
+		DroppedPath:        h.Data.DroppedPath,
+		ImportedPath:       h.Data.ImportedPath,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+	}
+}
+
+// Failed returns the data for a failed download event, or nil if the record is another event type.
+func (h *HistoryRecord) Failed() *starr.FailedData {
+	if h.Filter() != FilterDownloadFailed {
+		return nil
+	}
+
+	size, _ := strconv.ParseInt(h.Data.Size, 10, 64) //nolint:gomnd
+
+	return &starr.FailedData{
+		Message:            h.Data.Message,
+		Indexer:            h.Data.Indexer,
+		ReleaseGroup:       h.Data.ReleaseGroup,
+		Size:               size,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+		Protocol:           h.Data.Protocol,
+	}
+}
+
+// Deleted returns the data for a deleted file event, or nil if the record is another event type.
+func (h *HistoryRecord) Deleted() *starr.DeletedData {
+	if h.Filter() != FilterDeleted {
+		return nil
+	}
+
+	return &starr.DeletedData{Reason: h.Data.Reason}
+}
+
+// Renamed returns the data for a renamed file event, or nil if the record is another event type.
+func (h *HistoryRecord) Renamed() *starr.RenamedData {
+	if h.Filter() != FilterRenamed {
+		return nil
+	}
+
+	return &starr.RenamedData{
+		SourcePath:         h.Data.SourcePath,
+		SourceRelativePath: h.Data.SourceRelativePath,
+		Path:               h.Data.Path,
+		RelativePath:       h.Data.RelativePath,
+	}
+}
+
 // GetHistory returns the Sonarr History (grabs/failures/completed).
 // If you need control over the page, use sonarr.GetHistoryPage().
 // This function simply returns the number of history records desired,
@@ -120,6 +224,58 @@ func (s *Sonarr) GetHistoryPageContext(ctx context.Context, params *starr.PageRe
 	return &output, nil
 }
 
+// GetHistorySince returns all of the history records since a date.
+// Provide a filter, ie. FilterGrabbed, to return only that event type, or zero for all of them.
+func (s *Sonarr) GetHistorySince(date time.Time, filter starr.Filtering) ([]*HistoryRecord, error) {
+	return s.GetHistorySinceContext(context.Background(), date, filter)
+}
+
+// GetHistorySinceContext returns all of the history records since a date.
+func (s *Sonarr) GetHistorySinceContext(ctx context.Context,
+	date time.Time, filter starr.Filtering,
+) ([]*HistoryRecord, error) {
+	var output []*HistoryRecord
+
+	req := starr.Request{URI: path.Join(bpHistory, "since"), Query: make(url.Values)}
+	req.Query.Set("date", date.UTC().Format(time.RFC3339))
+
+	if filter > 0 {
+		req.Query.Set("eventType", filter.Param())
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetSeriesHistory returns all of the history records for a single series.
+// Provide a filter, ie. FilterGrabbed, to return only that event type, or zero for all of them.
+func (s *Sonarr) GetSeriesHistory(seriesID int64, filter starr.Filtering) ([]*HistoryRecord, error) {
+	return s.GetSeriesHistoryContext(context.Background(), seriesID, filter)
+}
+
+// GetSeriesHistoryContext returns all of the history records for a single series.
+func (s *Sonarr) GetSeriesHistoryContext(ctx context.Context,
+	seriesID int64, filter starr.Filtering,
+) ([]*HistoryRecord, error) {
+	var output []*HistoryRecord
+
+	req := starr.Request{URI: path.Join(bpHistory, "series"), Query: make(url.Values)}
+	req.Query.Set("seriesId", starr.Itoa(seriesID))
+
+	if filter > 0 {
+		req.Query.Set("eventType", filter.Param())
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
 // Fail marks the given history item as failed by id.
 func (s *Sonarr) Fail(historyID int64) error {
 	return s.FailContext(context.Background(), historyID)
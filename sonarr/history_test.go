@@ -0,0 +1,142 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+const historyBody = `{"page": 1, "pageSize": 10, "sortKey": "date", "sortDirection": "descending", "totalRecords": 4,
+"records": [
+	{"id": 1, "episodeId": 2, "seriesId": 3, "sourceTitle": "Series.Title.S01E01.720p.HDTV.x264-GROUP",
+	 "eventType": "grabbed", "data": {"indexer": "Indexer (Hydra)", "releaseGroup": "GROUP", "size": "1234567890",
+	 "downloadClient": "qBittorrent", "downloadClientName": "qBit", "age": "3", "protocol": "torrent",
+	 "publishedDate": "2023-01-02T03:04:05Z", "torrentInfoHash": "ABCDEF"}},
+	{"id": 2, "eventType": "downloadFolderImported", "data": {"droppedPath": "/downloads/file.mkv",
+	 "importedPath": "/tv/Series Title/Season 01/file.mkv", "downloadClient": "qBittorrent"}},
+	{"id": 3, "eventType": "episodeFileDeleted", "data": {"reason": "Upgrade"}},
+	{"id": 4, "eventType": "episodeFileRenamed", "data": {"sourcePath": "/tv/a.mkv", "sourceRelativePath": "a.mkv",
+	 "path": "/tv/b.mkv", "relativePath": "b.mkv"}}
+]}`
+
+func TestGetHistoryPage(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		Name: "200",
+		ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+			"history?eventType=1&page=1&pageSize=10&sortDirection=descending&sortKey=date"),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   historyBody,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetHistoryPage(&starr.PageReq{Filter: sonarr.FilterGrabbed, SortDir: starr.SortDescend})
+	require.NoError(t, err)
+	require.Len(t, output.Records, 4)
+
+	grabbed := output.Records[0]
+	assert.Equal(t, sonarr.FilterGrabbed, grabbed.Filter())
+	assert.Nil(t, grabbed.Imported())
+	assert.Nil(t, grabbed.Failed())
+	assert.Equal(t, &starr.GrabbedData{
+		Indexer:            "Indexer (Hydra)",
+		ReleaseGroup:       "GROUP",
+		Size:               1234567890,
+		DownloadClient:     "qBittorrent",
+		DownloadClientName: "qBit",
+		Protocol:           "torrent",
+		PublishedDate:      time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		Age:                3,
+		TorrentInfoHash:    "ABCDEF",
+	}, grabbed.Grabbed())
+
+	imported := output.Records[1]
+	assert.Equal(t, sonarr.FilterDownloadFolderImported, imported.Filter())
+	assert.Nil(t, imported.Grabbed())
+	assert.Equal(t, &starr.ImportedData{
+		DroppedPath:    "/downloads/file.mkv",
+		ImportedPath:   "/tv/Series Title/Season 01/file.mkv",
+		DownloadClient: "qBittorrent",
+	}, imported.Imported())
+
+	assert.Equal(t, sonarr.FilterDeleted, output.Records[2].Filter())
+	assert.Equal(t, &starr.DeletedData{Reason: "Upgrade"}, output.Records[2].Deleted())
+	assert.Equal(t, &starr.RenamedData{
+		SourcePath: "/tv/a.mkv", SourceRelativePath: "a.mkv", Path: "/tv/b.mkv", RelativePath: "b.mkv",
+	}, output.Records[3].Renamed())
+	assert.Nil(t, output.Records[3].Deleted())
+	assert.Equal(t, sonarr.FilterUnknown, (&sonarr.HistoryRecord{EventType: "somethingNew"}).Filter())
+}
+
+func TestGetHistorySince(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("test", -3600))
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"history/since?date=2023-01-02T04%3A04%3A05Z&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 2, "eventType": "downloadFolderImported", "data": {"importedPath": "/tv/file.mkv"}}]`,
+			WithResponse:   "/tv/file.mkv",
+			WithError:      nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"history/since?date=2023-01-02T04%3A04%3A05Z&eventType=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHistorySince(date, sonarr.FilterDownloadFolderImported)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+
+			if test.WithError == nil {
+				require.Len(t, output, 1)
+				assert.Equal(t, test.WithResponse, output[0].Imported().ImportedPath)
+			}
+		})
+	}
+}
+
+func TestGetSeriesHistory(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		Name:           "200",
+		ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "history/series?seriesId=3"),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `[{"id": 1, "seriesId": 3, "eventType": "grabbed"}, {"id": 2, "seriesId": 3, "eventType": "downloadFailed"}]`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetSeriesHistory(3, sonarr.FilterUnknown)
+	require.NoError(t, err)
+	require.Len(t, output, 2)
+	assert.Equal(t, sonarr.FilterGrabbed, output[0].Filter())
+	assert.Equal(t, sonarr.FilterDownloadFailed, output[1].Filter())
+}
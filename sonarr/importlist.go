@@ -180,3 +180,131 @@ func (s *Sonarr) DeleteImportListContext(ctx context.Context, importListID int64
 
 	return nil
 }
+
+// GetImportListSchema returns every import list implementation, and the fields each one accepts.
+// Use ImportListInputFromSchema to turn an entry into a new ImportListInput.
+func (s *Sonarr) GetImportListSchema() ([]*ImportListOutput, error) {
+	return s.GetImportListSchemaContext(context.Background())
+}
+
+// GetImportListSchemaContext returns every import list implementation, and the fields each one accepts.
+func (s *Sonarr) GetImportListSchemaContext(ctx context.Context) ([]*ImportListOutput, error) {
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// ImportListInputFromSchema builds an ImportListInput from a schema entry and a map of field names to values.
+// Fields that are not in the map use the schema default. See starr.FieldsFromSchema for validation details.
+// The returned input is not nil when an error is returned, so it may still be inspected.
+func ImportListInputFromSchema(schema *ImportListOutput, values map[string]interface{}) (*ImportListInput, error) {
+	fields, err := starr.FieldsFromSchema(schema.Fields, values)
+	input := &ImportListInput{
+		EnableAutomaticAdd: schema.EnableAutomaticAdd,
+		SeasonFolder:       schema.SeasonFolder,
+		QualityProfileID:   schema.QualityProfileID,
+		ConfigContract:     schema.ConfigContract,
+		Implementation:     schema.Implementation,
+		ImplementationName: schema.ImplementationName,
+		InfoLink:           schema.InfoLink,
+		ListType:           schema.ListType,
+		MinRefreshInterval: schema.MinRefreshInterval,
+		Name:               schema.Name,
+		RootFolderPath:     schema.RootFolderPath,
+		SeriesType:         schema.SeriesType,
+		ShouldMonitor:      schema.ShouldMonitor,
+		Tags:               schema.Tags,
+		Fields:             fields,
+	}
+
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", schema.Implementation, err)
+	}
+
+	return input, nil
+}
+
+// BulkImportList is the input for the bulk import list editor, UpdateBulkImportLists.
+// Set IDs to the import lists you want to change, and set only the members you want to update.
+// You may use starr.True(), starr.False() and starr.Int64() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for ApplyTags.
+type BulkImportList struct {
+	IDs                []int64          `json:"ids"`
+	Tags               []int            `json:"tags,omitempty"`
+	ApplyTags          *starr.ApplyTags `json:"applyTags,omitempty"`
+	EnableAutomaticAdd *bool            `json:"enableAutomaticAdd,omitempty"`
+	RootFolderPath     *string          `json:"rootFolderPath,omitempty"`
+	QualityProfileID   *int64           `json:"qualityProfileId,omitempty"`
+}
+
+// UpdateBulkImportLists updates many import lists at once. Only the non-nil members of the input are changed.
+func (s *Sonarr) UpdateBulkImportLists(bulk *BulkImportList) ([]*ImportListOutput, error) {
+	return s.UpdateBulkImportListsContext(context.Background(), bulk)
+}
+
+// UpdateBulkImportListsContext updates many import lists at once. Only the non-nil members of the input are changed.
+func (s *Sonarr) UpdateBulkImportListsContext(ctx context.Context, bulk *BulkImportList) ([]*ImportListOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBulkImportLists removes many import lists at once.
+func (s *Sonarr) DeleteBulkImportLists(ids []int64) error {
+	return s.DeleteBulkImportListsContext(context.Background(), ids)
+}
+
+// DeleteBulkImportListsContext removes many import lists at once.
+func (s *Sonarr) DeleteBulkImportListsContext(ctx context.Context, ids []int64) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllImportLists tests every configured import list, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (s *Sonarr) TestAllImportLists() ([]*starr.ProviderTestResult, error) {
+	return s.TestAllImportListsContext(context.Background())
+}
+
+// TestAllImportListsContext tests every configured import list, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (s *Sonarr) TestAllImportListsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var output []*starr.ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpImportList, "testall")}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		if output, err = starr.ProviderTestResults(err); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	}
+
+	return output, nil
+}
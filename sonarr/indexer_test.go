@@ -477,3 +477,224 @@ func TestDeleteIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIndexerSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "schema"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody: `[{"enableRss":true,"priority":25,"implementation":"Newznab","configContract":"NewznabSettings",` +
+				`"protocol":"usenet","fields":[{"name":"baseUrl","type":"textbox"},` +
+				`{"name":"apiPath","type":"textbox","value":"/api","advanced":true}],"tags":[]}]`,
+			WithResponse: []*sonarr.IndexerOutput{{
+				EnableRss:      true,
+				Priority:       25,
+				Implementation: "Newznab",
+				ConfigContract: "NewznabSettings",
+				Protocol:       "usenet",
+				Fields: []*starr.FieldOutput{
+					{Name: "baseUrl", Type: "textbox"},
+					{Name: "apiPath", Type: "textbox", Value: "/api", Advanced: true},
+				},
+				Tags: []int{},
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "schema"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*sonarr.IndexerOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetIndexerSchema()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestIndexerInputFromSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &sonarr.IndexerOutput{
+		EnableRss:      true,
+		Priority:       25,
+		Implementation: "Newznab",
+		ConfigContract: "NewznabSettings",
+		Protocol:       "usenet",
+		Fields: []*starr.FieldOutput{
+			{Name: "baseUrl", Type: "textbox"},
+			{Name: "apiPath", Type: "textbox", Value: "/api", Advanced: true},
+		},
+	}
+
+	input, err := sonarr.IndexerInputFromSchema(schema, map[string]interface{}{"baseUrl": "https://api.nzbgeek.info"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, &sonarr.IndexerInput{
+		EnableRss:      true,
+		Priority:       25,
+		Implementation: "Newznab",
+		ConfigContract: "NewznabSettings",
+		Protocol:       "usenet",
+		Fields: []*starr.FieldInput{
+			{Name: "baseUrl", Value: "https://api.nzbgeek.info"},
+			{Name: "apiPath", Value: "/api"},
+		},
+	}, input)
+
+	_, err = sonarr.IndexerInputFromSchema(schema, nil)
+	assert.ErrorIs(t, err, starr.ErrRequestError, "baseUrl must be required")
+}
+
+func TestUpdateBulkIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "indexer", "bulk"),
+			ExpectedMethod:  "PUT",
+			ExpectedRequest: `{"ids":[1,2],"tags":[3],"applyTags":"add","priority":10}` + "\n",
+			ResponseStatus:  202,
+			ResponseBody:    `[{"id":1,"priority":10,"tags":[3]},{"id":2,"priority":10,"tags":[3]}]`,
+			WithRequest: &sonarr.BulkIndexer{
+				IDs:       []int64{1, 2},
+				Tags:      []int{3},
+				ApplyTags: starr.TagsAdd.Ptr(),
+				Priority:  starr.Int64(10),
+			},
+			WithResponse: []*sonarr.IndexerOutput{
+				{ID: 1, Priority: 10, Tags: []int{3}},
+				{ID: 2, Priority: 10, Tags: []int{3}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "indexer", "bulk"),
+			ExpectedMethod:  "PUT",
+			ExpectedRequest: `{"ids":[1],"enableRss":false}` + "\n",
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     &sonarr.BulkIndexer{IDs: []int64{1}, EnableRss: starr.False()},
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*sonarr.IndexerOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateBulkIndexers(test.WithRequest.(*sonarr.BulkIndexer))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteBulkIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "indexer", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ExpectedRequest: `{"ids":[1,2]}` + "\n",
+			ResponseStatus:  200,
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "indexer", "bulk"),
+			ExpectedMethod:  "DELETE",
+			ExpectedRequest: `{"ids":[1,2]}` + "\n",
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteBulkIndexers([]int64{1, 2})
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
+
+func TestTestAllIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 200,
+			ResponseBody:   `[{"id":1,"isValid":true,"validationFailures":[]}]`,
+			WithResponse: []*starr.ProviderTestResult{
+				{ID: 1, IsValid: true, ValidationFailures: starr.ValidationErrors{}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "400",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 400,
+			ResponseBody: `[{"id":1,"isValid":true,"validationFailures":[]},{"id":2,"isValid":false,` +
+				`"validationFailures":[{"propertyName":"ApiKey","errorMessage":"Invalid API Key","severity":"error"}]}]`,
+			WithResponse: []*starr.ProviderTestResult{
+				{ID: 1, IsValid: true, ValidationFailures: starr.ValidationErrors{}},
+				{ID: 2, ValidationFailures: starr.ValidationErrors{
+					{PropertyName: "ApiKey", ErrorMessage: "Invalid API Key", Severity: "error"},
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "indexer", "testall"),
+			ExpectedMethod: "POST",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*starr.ProviderTestResult)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.TestAllIndexers()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
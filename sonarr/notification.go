@@ -157,3 +157,75 @@ func (s *Sonarr) DeleteNotificationContext(ctx context.Context, notificationID i
 
 	return nil
 }
+
+// GetNotificationSchema returns every notification implementation, and the fields each one accepts.
+// Use NotificationInputFromSchema to turn an entry into a new NotificationInput.
+func (s *Sonarr) GetNotificationSchema() ([]*NotificationOutput, error) {
+	return s.GetNotificationSchemaContext(context.Background())
+}
+
+// GetNotificationSchemaContext returns every notification implementation, and the fields each one accepts.
+func (s *Sonarr) GetNotificationSchemaContext(ctx context.Context) ([]*NotificationOutput, error) {
+	var output []*NotificationOutput
+
+	req := starr.Request{URI: path.Join(bpNotification, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// NotificationInputFromSchema builds a NotificationInput from a schema entry and a map of field names to values.
+// Fields that are not in the map use the schema default. See starr.FieldsFromSchema for validation details.
+// The returned input is not nil when an error is returned, so it may still be inspected.
+func NotificationInputFromSchema(
+	schema *NotificationOutput,
+	values map[string]interface{},
+) (*NotificationInput, error) {
+	fields, err := starr.FieldsFromSchema(schema.Fields, values)
+	input := &NotificationInput{
+		OnGrab:                        schema.OnGrab,
+		OnDownload:                    schema.OnDownload,
+		OnUpgrade:                     schema.OnUpgrade,
+		OnRename:                      schema.OnRename,
+		OnSeriesDelete:                schema.OnSeriesDelete,
+		OnEpisodeFileDelete:           schema.OnEpisodeFileDelete,
+		OnEpisodeFileDeleteForUpgrade: schema.OnEpisodeFileDeleteForUpgrade,
+		OnHealthIssue:                 schema.OnHealthIssue,
+		OnApplicationUpdate:           schema.OnApplicationUpdate,
+		IncludeHealthWarnings:         schema.IncludeHealthWarnings,
+		Name:                          schema.Name,
+		Implementation:                schema.Implementation,
+		ConfigContract:                schema.ConfigContract,
+		Tags:                          schema.Tags,
+		Fields:                        fields,
+	}
+
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", schema.Implementation, err)
+	}
+
+	return input, nil
+}
+
+// TestAllNotifications tests every configured notification, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (s *Sonarr) TestAllNotifications() ([]*starr.ProviderTestResult, error) {
+	return s.TestAllNotificationsContext(context.Background())
+}
+
+// TestAllNotificationsContext tests every configured notification, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (s *Sonarr) TestAllNotificationsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var output []*starr.ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpNotification, "testall")}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		if output, err = starr.ProviderTestResults(err); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	}
+
+	return output, nil
+}
@@ -48,6 +48,7 @@ type ParsedEpisodeInfo struct {
 	ReleaseHash                   string           `json:"releaseHash"`
 	ReleaseTokens                 string           `json:"releaseTokens"`
 	ReleaseType                   string           `json:"releaseType"`
+	AirDate                       string           `json:"airDate,omitempty"`
 	SeriesTitleInfo               *SeriesTitleInfo `json:"seriesTitleInfo"`
 	Quality                       *starr.Quality   `json:"quality"`
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
@@ -111,6 +112,54 @@ func (s *Sonarr) GetQueuePageContext(ctx context.Context, params *starr.PageReq)
 	return &output, nil
 }
 
+// GetQueueDetails returns every record in the Activity Queue without paging.
+// Provide a series ID to return only the records for that series, and episode IDs to narrow it
+// down further. A zero series ID returns all of them, including items that are not matched to one.
+func (s *Sonarr) GetQueueDetails(seriesID int64, episodeIDs ...int64) ([]*QueueRecord, error) {
+	return s.GetQueueDetailsContext(context.Background(), seriesID, episodeIDs...)
+}
+
+// GetQueueDetailsContext returns every record in the Activity Queue without paging.
+func (s *Sonarr) GetQueueDetailsContext(ctx context.Context,
+	seriesID int64, episodeIDs ...int64,
+) ([]*QueueRecord, error) {
+	var output []*QueueRecord
+
+	req := starr.Request{URI: path.Join(bpQueue, "details"), Query: make(url.Values)}
+	req.Query.Set("includeUnknownSeriesItems", "true")
+
+	if seriesID > 0 {
+		req.Query.Set("seriesId", starr.Itoa(seriesID))
+	}
+
+	for _, id := range episodeIDs {
+		req.Query.Add("episodeIds", starr.Itoa(id))
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetQueueStatus returns the number of records in the Activity Queue, and if any of them have errors or warnings.
+func (s *Sonarr) GetQueueStatus() (*starr.QueueStatus, error) {
+	return s.GetQueueStatusContext(context.Background())
+}
+
+// GetQueueStatusContext returns the number of records in the Activity Queue, and if any have errors or warnings.
+func (s *Sonarr) GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error) {
+	var output starr.QueueStatus
+
+	req := starr.Request{URI: path.Join(bpQueue, "status")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
 // DeleteQueue deletes an item from the Activity Queue.
 func (s *Sonarr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
 	return s.DeleteQueueContext(context.Background(), queueID, opts)
@@ -126,6 +175,31 @@ func (s *Sonarr) DeleteQueueContext(ctx context.Context, queueID int64, opts *st
 	return nil
 }
 
+// DeleteBulkQueue deletes many items from the Activity Queue at once.
+// The delete options are applied to every item.
+func (s *Sonarr) DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	return s.DeleteBulkQueueContext(context.Background(), queueIDs, opts)
+}
+
+// DeleteBulkQueueContext deletes many items from the Activity Queue at once.
+func (s *Sonarr) DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: queueIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpQueue, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpQueue, "bulk"), Query: opts.Values(), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
 // QueueGrab tells the app to grab an item that's in queue.
 // Most often used on items with a delay set from a delay profile.
 func (s *Sonarr) QueueGrab(ids ...int64) error {
@@ -0,0 +1,135 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetQueueDetails(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"queue/details?episodeIds=4&episodeIds=5&includeUnknownSeriesItems=true&seriesId=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "seriesId": 3, "episodeId": 4, "title": "Series.Title.S01E01", "status": "downloading"}]`,
+			WithResponse: []*sonarr.QueueRecord{
+				{ID: 1, SeriesID: 3, EpisodeID: 4, Title: "Series.Title.S01E01", Status: "downloading"},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"queue/details?episodeIds=4&episodeIds=5&includeUnknownSeriesItems=true&seriesId=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*sonarr.QueueRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetQueueDetails(3, 4, 5)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetQueueStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "queue/status"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"id": 0, "totalCount": 12, "count": 10, "unknownCount": 2, "errors": true,
+				"warnings": false, "unknownErrors": false, "unknownWarnings": true}`,
+			WithResponse: &starr.QueueStatus{
+				TotalCount: 12, Count: 10, UnknownCount: 2, Errors: true, UnknownWarnings: true,
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "queue/status"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*starr.QueueStatus)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetQueueStatus()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteBulkQueue(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"queue/bulk?blocklist=true&changeCategory=false&removeFromClient=false&skipRedownload=true"),
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"ids":[1,2,3]}` + "\n",
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver,
+				"queue/bulk?blocklist=true&changeCategory=false&removeFromClient=false&skipRedownload=true"),
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"ids":[1,2,3]}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteBulkQueue([]int64{1, 2, 3}, &starr.QueueDeleteOpts{
+				RemoveFromClient: starr.False(),
+				BlockList:        true,
+				SkipRedownload:   true,
+			})
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"golift.io/starr"
+)
+
+const bpRename = APIver + "/rename"
+
+// Rename is the /api/v3/rename endpoint.
+// This is a preview of the changes the RenameFiles command will make.
+type Rename struct {
+	SeriesID       int64  `json:"seriesId"`
+	SeasonNumber   int    `json:"seasonNumber"`
+	EpisodeNumbers []int  `json:"episodeNumbers"`
+	EpisodeFileID  int64  `json:"episodeFileId"`
+	ExistingPath   string `json:"existingPath"`
+	NewPath        string `json:"newPath"`
+}
+
+// GetRenames returns the files that would be renamed for a series.
+// Set seasonNumber to -1 to preview every season.
+// Pass the EpisodeFileID values to SendCommand with a RenameFiles command to apply the changes.
+func (s *Sonarr) GetRenames(seriesID int64, seasonNumber int) ([]*Rename, error) {
+	return s.GetRenamesContext(context.Background(), seriesID, seasonNumber)
+}
+
+// GetRenamesContext returns the files that would be renamed for a series.
+// Set seasonNumber to -1 to preview every season.
+func (s *Sonarr) GetRenamesContext(ctx context.Context, seriesID int64, seasonNumber int) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: make(url.Values)}
+	req.Query.Set("seriesId", starr.Itoa(seriesID))
+
+	if seasonNumber >= 0 {
+		req.Query.Set("seasonNumber", strconv.Itoa(seasonNumber))
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
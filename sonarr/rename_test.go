@@ -0,0 +1,77 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+const renameBody = `[{
+	"seriesId": 7,
+	"seasonNumber": 1,
+	"episodeNumbers": [1, 2],
+	"episodeFileId": 12,
+	"existingPath": "Season 1/show.s01e01e02.mkv",
+	"newPath": "Season 1/Show - S01E01-E02 - Pilot.mkv"
+}]`
+
+func TestGetRenames(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "rename?seasonNumber=1&seriesId=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   renameBody,
+			WithRequest:    1,
+			WithResponse: []*sonarr.Rename{{
+				SeriesID:       7,
+				SeasonNumber:   1,
+				EpisodeNumbers: []int{1, 2},
+				EpisodeFileID:  12,
+				ExistingPath:   "Season 1/show.s01e01e02.mkv",
+				NewPath:        "Season 1/Show - S01E01-E02 - Pilot.mkv",
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "200 all seasons",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "rename?seriesId=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[]`,
+			WithRequest:    -1,
+			WithResponse:   []*sonarr.Rename{},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "rename?seasonNumber=1&seriesId=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    1,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*sonarr.Rename)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRenames(7, test.WithRequest.(int))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -19,6 +19,7 @@ package starr
 import (
 	"errors"
 	"net/http"
+	"sync/atomic"
 	"time"
 )
 
@@ -47,6 +48,7 @@ var (
 // At a minimum, provide a URL and API Key.
 // HTTPUser and HTTPPass are used for Basic HTTP auth, if enabled (not common).
 // Username and Password are for non-API paths with native authentication enabled.
+// Cache is optional, and caches API GET responses. See NewCache.
 type Config struct {
 	APIKey   string       `json:"apiKey" toml:"api_key" xml:"api_key" yaml:"apiKey"`
 	URL      string       `json:"url" toml:"url" xml:"url" yaml:"url"`
@@ -55,12 +57,15 @@ type Config struct {
 	Username string       `json:"username" toml:"username" xml:"username" yaml:"username"`
 	Password string       `json:"password" toml:"password" xml:"password" yaml:"password"`
 	Client   *http.Client `json:"-" toml:"-" xml:"-" yaml:"-"`
-	cookie   bool         // this probably doesn't work right.
+	Cache    *Cache       `json:"-" toml:"-" xml:"-" yaml:"-"`
+	basic    int32        // 1 when the app asks for Basic auth on a non-API path.
+	session  atomic.Value // []*http.Cookie from the last login, with their attributes. See SaveCookies.
 }
 
 // New returns a *starr.Config pointer. This pointer is safe to modify
 // further before passing it into one of the starr app New() procedures.
-func New(apiKey, appURL string, timeout time.Duration) *Config {
+// SSL certificates are not verified unless the WithCAPool option is provided.
+func New(apiKey, appURL string, timeout time.Duration, opts ...ClientOption) *Config {
 	if timeout == 0 {
 		timeout = DefaultTimeout
 	}
@@ -68,6 +73,6 @@ func New(apiKey, appURL string, timeout time.Duration) *Config {
 	return &Config{
 		APIKey: apiKey,
 		URL:    appURL,
-		Client: Client(timeout, false),
+		Client: Client(timeout, false, opts...),
 	}
 }
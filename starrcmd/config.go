@@ -13,12 +13,15 @@ import (
 - Sizes should be int64 (bytes).
 - Avoid uint* int8, int16, int32, float32, or add parsers for them. See golift.io/cnfg.
 - Avoid external modules for env parsing; those require custom types.
-- Some slices are allowed, add more when needed. See parseSlices().
-  - Slices must have a split character. ,, or ,| (usually).
-  - Missing the split character will cause a panic() during parsing.
-  - Add tests for all methods and data types to catch panics before release.
+- Slices and maps of any supported type are allowed. See parseSlice() and parseMap().
+  - Slices and maps must have a split character. ,, or ,| (usually).
+  - Map items are key=value pairs, and map keys must be strings.
+  - Missing the split character, or an unsupported type, returns an error during parsing.
+  - Add tests for all methods and data types, and run the fuzz tests before release.
+- Nested structs use their env tag as a prefix for the tags on their members.
+- New types must be added to the encoder (formatStructMember) too. Add new events to encoder_test.go.
 - The time.Time format is hard coded (twice). If new formats arise, find a way to fix it.
-- No time.Duration types exist, but we can write a parser for those if they arise.
+- time.Duration and starr.PlayTime parse Go durations (1h2m) and C# TimeSpans (hh:mm:ss).
 */
 
 var (
@@ -27,6 +30,16 @@ var (
 	// ErrNoEventFound is returned if an event type is not found.
 	// This should only happen when testing and you forget a variable.
 	ErrNoEventFound = fmt.Errorf("no eventType environment variable found")
+	// ErrInvalidData is returned if the event data is not a struct, or a pointer to one.
+	ErrInvalidData = fmt.Errorf("event data must be a struct or a pointer to a struct")
+	// ErrUnsupportedType is returned if a struct member has a type the parser or encoder does not handle.
+	ErrUnsupportedType = fmt.Errorf("unsupported type")
+	// ErrNoSplit is returned if a slice or map struct member does not have a split character in its env tag.
+	ErrNoSplit = fmt.Errorf("env tag is missing a split character")
+	// ErrInvalidPair is returned if a map value is not formatted as key=value.
+	ErrInvalidPair = fmt.Errorf("map value is not a key=value pair")
+	// ErrInvalidDuration is returned if a duration is not a Go duration or a TimeSpan (hh:mm:ss).
+	ErrInvalidDuration = fmt.Errorf("invalid duration")
 )
 
 // DateFormat matches the date output from most apps.
@@ -44,22 +57,31 @@ type Event string
 // Radarr is complete; 1/30/2022.
 // Readarr is complete; 1/30/2022.
 // Sonarr is complete; 1/30/2022.
+// HealthRestored, ManualInteractionRequired and the Add events were added in later app versions.
 const (
-	EventTest              Event = "Test"              // All Apps, useless
-	EventHealthIssue       Event = "HealthIssue"       // All Apps
-	EventApplicationUpdate Event = "ApplicationUpdate" // All Apps
-	EventGrab              Event = "Grab"              // All Apps except Prowlarr
-	EventRename            Event = "Rename"            // All Apps except Prowlarr
-	EventDownload          Event = "Download"          // All Apps except Prowlarr/Lidarr
-	EventTrackRetag        Event = "TrackRetag"        // Lidarr & Readarr
-	EventAlbumDownload     Event = "AlbumDownload"     // Lidarr
-	EventMovieFileDelete   Event = "MovieFileDelete"   // Radarr
-	EventMovieDelete       Event = "MovieDelete"       // Radarr
-	EventBookDelete        Event = "BookDelete"        // Readarr
-	EventAuthorDelete      Event = "AuthorDelete"      // Readarr
-	EventBookFileDelete    Event = "BookFileDelete"    // Readarr
-	EventSeriesDelete      Event = "SeriesDelete"      // Sonarr
-	EventEpisodeFileDelete Event = "EpisodeFileDelete" // Sonarr
+	EventTest                      Event = "Test"                      // All Apps, useless
+	EventHealthIssue               Event = "HealthIssue"               // All Apps
+	EventHealthRestored            Event = "HealthRestored"            // All Apps
+	EventApplicationUpdate         Event = "ApplicationUpdate"         // All Apps
+	EventGrab                      Event = "Grab"                      // All Apps except Prowlarr
+	EventRename                    Event = "Rename"                    // All Apps except Prowlarr
+	EventDownload                  Event = "Download"                  // All Apps except Prowlarr/Lidarr
+	EventManualInteractionRequired Event = "ManualInteractionRequired" // Radarr & Sonarr
+	EventTrackRetag                Event = "TrackRetag"                // Lidarr & Readarr
+	EventAlbumDownload             Event = "AlbumDownload"             // Lidarr
+	EventArtistAdd                 Event = "ArtistAdd"                 // Lidarr
+	EventArtistDelete              Event = "ArtistDelete"              // Lidarr
+	EventAlbumDelete               Event = "AlbumDelete"               // Lidarr
+	EventMovieAdded                Event = "MovieAdded"                // Radarr
+	EventMovieFileDelete           Event = "MovieFileDelete"           // Radarr
+	EventMovieDelete               Event = "MovieDelete"               // Radarr
+	EventAuthorAdded               Event = "AuthorAdded"               // Readarr
+	EventBookDelete                Event = "BookDelete"                // Readarr
+	EventAuthorDelete              Event = "AuthorDelete"              // Readarr
+	EventBookFileDelete            Event = "BookFileDelete"            // Readarr
+	EventSeriesAdd                 Event = "SeriesAdd"                 // Sonarr
+	EventSeriesDelete              Event = "SeriesDelete"              // Sonarr
+	EventEpisodeFileDelete         Event = "EpisodeFileDelete"         // Sonarr
 )
 
 // CmdEvent holds the current event type and the app that triggered it.
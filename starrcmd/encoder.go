@@ -0,0 +1,248 @@
+package starrcmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golift.io/starr"
+)
+
+/* This file contains the reverse of the parser. It turns event data back into the environment
+ * variables a Starr app sets when it runs a Custom Script. Use it to test your scripts. */
+
+// Marshal returns the environment variables a Starr app sets for an event, including the event type.
+// Set App and Type on the CmdEvent, and pass in the matching data, ie. RadarrDownload for a Radarr Download event.
+// Every tagged member is included; zero times and nil slices are empty strings.
+// Readarr dates use DateFormat2, the other apps use DateFormat.
+func (c *CmdEvent) Marshal(data interface{}) (map[string]string, error) {
+	field := reflect.ValueOf(data)
+	if field.Kind() == reflect.Ptr {
+		field = field.Elem()
+	}
+
+	if field.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %T", ErrInvalidData, data)
+	}
+
+	dateFormat := DateFormat
+	if c.App == starr.Readarr {
+		dateFormat = DateFormat2
+	}
+
+	env := map[string]string{c.App.Lower() + "_eventtype": string(c.Type)}
+	if err := marshalStruct(field, "", dateFormat, env); err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
+
+// Environ returns the environment variables from Marshal as a sorted list of key=value pairs.
+// The list does not include the current process environment. See exec.Cmd.Env.
+func (c *CmdEvent) Environ(data interface{}) ([]string, error) {
+	env, err := c.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]string, 0, len(env))
+	for key, value := range env {
+		list = append(list, key+"="+value)
+	}
+
+	sort.Strings(list)
+
+	return list, nil
+}
+
+// Command returns a command that runs with the event's environment variables added
+// to the current process environment, like a Starr app runs a Custom Script.
+func (c *CmdEvent) Command(ctx context.Context, data interface{}, name string, args ...string) (*exec.Cmd, error) {
+	env, err := c.Environ(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = append(os.Environ(), env...)
+
+	return cmd, nil
+}
+
+// WithEnv sets the event's environment variables in the current process, runs a function, and then
+// restores the environment. Use it to run your own event handler, or a Router, with a replayed event.
+// The environment is global, so do not use this in parallel tests.
+func (c *CmdEvent) WithEnv(data interface{}, function func() error) error {
+	env, err := c.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range env {
+		if old, ok := os.LookupEnv(key); ok {
+			defer os.Setenv(key, old)
+		} else {
+			defer os.Unsetenv(key)
+		}
+
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("setting environment: %w", err)
+		}
+	}
+
+	return function()
+}
+
+// marshalStruct formats every tagged member of a struct into the env map. Nested structs use their tag as a prefix.
+func marshalStruct(field reflect.Value, prefix, dateFormat string, env map[string]string) error {
+	t := field.Type()
+
+	for idx := 0; idx < t.NumField(); idx++ {
+		split := strings.SplitN(t.Field(idx).Tag.Get("env"), ",", 2) //nolint:gomnd
+
+		tag := strings.ToLower(split[0])
+		if !t.Field(idx).IsExported() || tag == "-" || tag == "" {
+			continue
+		}
+
+		if isNested(t.Field(idx).Type) {
+			if err := marshalStruct(field.Field(idx), prefix+tag, dateFormat, env); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		var splitVal string
+		if len(split) == 2 { //nolint:gomnd
+			splitVal = split[1]
+		}
+
+		value, err := formatStructMember(field.Field(idx), splitVal, dateFormat)
+		if err != nil {
+			return fmt.Errorf("%s: %w", prefix+tag, err)
+		}
+
+		env[prefix+tag] = value
+	}
+
+	return nil
+}
+
+// formatStructMember is the reverse of parseStructMember.
+func formatStructMember(field reflect.Value, splitVal, dateFormat string) (string, error) { //nolint:cyclop
+	switch val := field.Interface().(type) {
+	case time.Time:
+		if val.IsZero() {
+			return "", nil
+		}
+
+		return val.Format(dateFormat), nil
+	case time.Duration:
+		return formatDuration(val), nil
+	case starr.PlayTime:
+		if val.Original != "" || val.Duration == 0 {
+			return val.Original, nil
+		}
+
+		return formatDuration(val.Duration), nil
+	}
+
+	switch field.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return field.String(), nil
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), nil //nolint:gomnd
+	case reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64), nil //nolint:gomnd
+	case reflect.Bool:
+		// The apps are written in C#, and that's how it formats booleans.
+		if field.Bool() {
+			return "True", nil
+		}
+
+		return "False", nil
+	case reflect.Slice, reflect.Map:
+		if splitVal == "" {
+			return "", fmt.Errorf("%w: %s", ErrNoSplit, field.Type())
+		}
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, field.Type())
+	}
+
+	if field.Kind() == reflect.Map {
+		return formatMap(field, splitVal, dateFormat)
+	}
+
+	vals := make([]string, field.Len())
+
+	for idx := range vals {
+		val, err := formatStructMember(field.Index(idx), "", dateFormat)
+		if err != nil {
+			return "", fmt.Errorf("item %d: %w", idx, err)
+		}
+
+		vals[idx] = val
+	}
+
+	return strings.Join(vals, splitVal), nil
+}
+
+// formatMap formats a map as key=value pairs, sorted by key.
+func formatMap(field reflect.Value, splitVal, dateFormat string) (string, error) {
+	if field.Type().Key().Kind() != reflect.String {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, field.Type())
+	}
+
+	vals := make([]string, 0, field.Len())
+
+	for _, key := range field.MapKeys() {
+		val, err := formatStructMember(field.MapIndex(key), "", dateFormat)
+		if err != nil {
+			return "", fmt.Errorf("key %s: %w", key, err)
+		}
+
+		vals = append(vals, key.String()+"="+val)
+	}
+
+	sort.Strings(vals)
+
+	return strings.Join(vals, splitVal), nil
+}
+
+// formatDuration formats a duration like a C# TimeSpan: [-][d.]hh:mm:ss[.fffffff].
+// A duration a TimeSpan cannot hold exactly is formatted as a Go duration, so it parses back the same.
+func formatDuration(dur time.Duration) string {
+	// TimeSpan has 100 nanosecond ticks, and the parser reads up to 65535 days.
+	if dur%100 != 0 || dur/(24*time.Hour) > math.MaxUint16 || -dur/(24*time.Hour) > math.MaxUint16 { //nolint:gomnd
+		return dur.String()
+	}
+
+	var sign string
+	if dur < 0 {
+		sign, dur = "-", -dur
+	}
+
+	days := dur / (24 * time.Hour) //nolint:gomnd
+	dur -= days * 24 * time.Hour   //nolint:gomnd
+
+	output := fmt.Sprintf("%02d:%02d:%02d", dur/time.Hour, dur%time.Hour/time.Minute, dur%time.Minute/time.Second)
+	if days > 0 {
+		output = strconv.FormatInt(int64(days), 10) + "." + output //nolint:gomnd
+	}
+
+	// The fraction is 7 digits of ticks.
+	if ticks := dur % time.Second / 100; ticks > 0 { //nolint:gomnd
+		output += fmt.Sprintf(".%07d", ticks)
+	}
+
+	return sign + output
+}
@@ -0,0 +1,189 @@
+package starrcmd_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/starrcmd"
+)
+
+// events is every event data struct in the library. Add new ones here.
+var events = []interface{}{ //nolint:gochecknoglobals
+	starrcmd.LidarrApplicationUpdate{}, starrcmd.LidarrHealthIssue{}, starrcmd.LidarrGrab{},
+	starrcmd.LidarrAlbumDownload{}, starrcmd.LidarrRename{}, starrcmd.LidarrTrackRetag{}, starrcmd.LidarrTest{},
+	starrcmd.ProwlarrApplicationUpdate{}, starrcmd.ProwlarrHealthIssue{}, starrcmd.ProwlarrTest{},
+	starrcmd.RadarrApplicationUpdate{}, starrcmd.RadarrDownload{}, starrcmd.RadarrGrab{}, starrcmd.RadarrHealthIssue{},
+	starrcmd.RadarrMovieFileDelete{}, starrcmd.RadarrMovieDelete{}, starrcmd.RadarrRename{}, starrcmd.RadarrTest{},
+	starrcmd.ReadarrApplicationUpdate{}, starrcmd.ReadarrHealthIssue{}, starrcmd.ReadarrGrab{},
+	starrcmd.ReadarrBookDelete{}, starrcmd.ReadarrBookFileDelete{}, starrcmd.ReadarrAuthorDelete{},
+	starrcmd.ReadarrRename{}, starrcmd.ReadarrDownload{}, starrcmd.ReadarrTrackRetag{}, starrcmd.ReadarrTest{},
+	starrcmd.SonarrApplicationUpdate{}, starrcmd.SonarrHealthIssue{}, starrcmd.SonarrGrab{}, starrcmd.SonarrDownload{},
+	starrcmd.SonarrRename{}, starrcmd.SonarrSeriesDelete{}, starrcmd.SonarrEpisodeFileDelete{}, starrcmd.SonarrTest{},
+	// Events added after 1/30/2022.
+	starrcmd.LidarrHealthRestored{}, starrcmd.LidarrArtistAdd{}, starrcmd.LidarrArtistDelete{}, starrcmd.LidarrAlbumDelete{},
+	starrcmd.ProwlarrHealthRestored{}, starrcmd.RadarrHealthRestored{}, starrcmd.RadarrMovieAdded{},
+	starrcmd.RadarrManualInteractionRequired{}, starrcmd.ReadarrHealthRestored{}, starrcmd.ReadarrAuthorAdded{},
+	starrcmd.SonarrHealthRestored{}, starrcmd.SonarrSeriesAdd{}, starrcmd.SonarrManualInteractionRequired{},
+}
+
+// fill puts a value into every member of an event struct.
+func fill(data reflect.Value) {
+	date := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC) //nolint:gomnd
+
+	for idx := 0; idx < data.NumField(); idx++ {
+		switch field := data.Field(idx); field.Interface().(type) {
+		case string:
+			field.SetString(fmt.Sprint("value ", idx))
+		case int, int64:
+			field.SetInt(int64(idx + 1))
+		case bool:
+			field.SetBool(true)
+		case time.Time:
+			field.Set(reflect.ValueOf(date.AddDate(0, 0, idx)))
+		case []string:
+			field.Set(reflect.ValueOf([]string{"one", "two", "three"}))
+		case []int:
+			field.Set(reflect.ValueOf([]int{1, 2, 3}))
+		case []int64:
+			field.Set(reflect.ValueOf([]int64{4, 5, 6}))
+		case []time.Time:
+			field.Set(reflect.ValueOf([]time.Time{date, date.AddDate(1, 0, 0)}))
+		}
+	}
+}
+
+// cmdEvent returns the app and event for an event struct, ie. RadarrDownload is a Radarr Download event.
+func cmdEvent(data interface{}) *starrcmd.CmdEvent {
+	name := reflect.TypeOf(data).Name()
+
+	for _, app := range []starr.App{starr.Lidarr, starr.Prowlarr, starr.Radarr, starr.Readarr, starr.Sonarr} {
+		if strings.HasPrefix(name, app.String()) {
+			return &starrcmd.CmdEvent{App: app, Type: starrcmd.Event(strings.TrimPrefix(name, app.String()))}
+		}
+	}
+
+	return &starrcmd.CmdEvent{}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	for _, event := range events {
+		input := reflect.New(reflect.TypeOf(event))
+		fill(input.Elem())
+
+		cmd := cmdEvent(event)
+		name := reflect.TypeOf(event).Name()
+
+		err := cmd.WithEnv(input.Interface(), func() error {
+			parsed, err := starrcmd.New()
+			if err != nil {
+				return err
+			}
+
+			results := reflect.ValueOf(parsed).MethodByName("Get" + name).Call(nil)
+			if err, _ := results[1].Interface().(error); err != nil {
+				return err
+			}
+
+			if !reflect.DeepEqual(input.Elem().Interface(), results[0].Interface()) {
+				return fmt.Errorf("wrong data:\nwanted: %+v\n   got: %+v", input.Elem(), results[0]) //nolint:goerr113
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	if _, err := starrcmd.New(); err == nil {
+		t.Fatalf("the environment must be restored after WithEnv")
+	}
+}
+
+func TestEnviron(t *testing.T) {
+	cmd := &starrcmd.CmdEvent{App: starr.Sonarr, Type: starrcmd.EventGrab}
+
+	env, err := cmd.Environ(&starrcmd.SonarrGrab{
+		Title:              "Severance",
+		EpisodeNumbers:     []int{1, 2},
+		EpisodeTitles:      nil,
+		EpisodeAirDatesUTC: []time.Time{time.Date(2022, 2, 18, 0, 0, 0, 0, time.UTC)},
+	})
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	for _, wanted := range []string{
+		"sonarr_eventtype=Grab",
+		"sonarr_series_title=Severance",
+		"sonarr_release_episodenumbers=1,2",
+		"sonarr_release_episodeairdatesutc=2/18/2022 12:00:00 AM",
+		"sonarr_release_size=0",
+		"sonarr_download_client=",
+		"sonarr_release_episodetitles=",
+	} {
+		if !contains(env, wanted) {
+			t.Errorf("missing environment variable: %s", wanted)
+		}
+	}
+
+	if _, err = cmd.Environ("not a struct"); err == nil {
+		t.Fatalf("expected an error for invalid data")
+	}
+}
+
+func contains(list []string, wanted string) bool {
+	for _, item := range list {
+		if item == wanted {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TestCommandHelper is not a real test. It's the command run by TestCommand.
+func TestCommandHelper(t *testing.T) {
+	if os.Getenv("STARRCMD_TEST_HELPER") != "1" {
+		t.Skip("only runs as a helper for TestCommand")
+	}
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	download, err := cmd.GetRadarrDownload()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fmt.Println("title:", download.Title) //nolint:forbidigo
+}
+
+func TestCommand(t *testing.T) {
+	t.Setenv("STARRCMD_TEST_HELPER", "1")
+
+	cmd := &starrcmd.CmdEvent{App: starr.Radarr, Type: starrcmd.EventDownload}
+
+	command, err := cmd.Command(context.Background(), starrcmd.RadarrDownload{Title: "Arrival"},
+		os.Args[0], "-test.run=^TestCommandHelper$")
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	output, err := command.CombinedOutput()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s: %s", err, output)
+	}
+
+	if !strings.Contains(string(output), "title: Arrival") {
+		t.Fatalf("got wrong output? %s", output)
+	}
+}
@@ -3,6 +3,7 @@ package starrcmd_test
 
 import (
 	"fmt"
+	"os"
 
 	"golift.io/starr"
 	"golift.io/starr/starrcmd"
@@ -34,6 +35,29 @@ func Example() {
 	}
 }
 
+// This example does the same thing as the Example above, without the switch statements.
+func ExampleRouter() {
+	router := starrcmd.NewRouter()
+	router.OnRadarrGrab(func(grab starrcmd.RadarrGrab) error {
+		fmt.Println(grab.Title)
+		return nil
+	})
+	router.OnRadarrDownload(func(download starrcmd.RadarrDownload) error {
+		fmt.Println(download.Title)
+		return nil
+	})
+	router.OnSonarrHealthIssue(func(health starrcmd.SonarrHealthIssue) error {
+		fmt.Println(health.IssueType, health.Message)
+		return nil
+	})
+	router.Fallback(func(cmd *starrcmd.CmdEvent) error {
+		fmt.Println("Ignored Event: ", cmd.App, cmd.Type)
+		return nil
+	})
+
+	os.Exit(router.Run())
+}
+
 // DoRadarr handles any Radarr event.
 func DoRadarr(cmd *starrcmd.CmdEvent) { //nolint:cyclop
 	fmt.Println("Processing Radarr Event: ", cmd.Type)
@@ -3,11 +3,14 @@ package starrcmd
 
 /*
 All 7 Lidarr events are accounted for; 1/30/2022.
+Added HealthRestored, ArtistAdd, ArtistDelete and AlbumDelete, and custom format variables on Grab.
 https://github.com/Lidarr/Lidarr/blob/develop/src/NzbDrone.Core/Notifications/CustomScript/CustomScript.cs
 */
 
 import (
 	"time"
+
+	"golift.io/starr"
 )
 
 // LidarrApplicationUpdate is the ApplicationUpdate event.
@@ -25,24 +28,34 @@ type LidarrHealthIssue struct {
 	Level     string `env:"lidarr_health_issue_level"`   // Warning
 }
 
+// LidarrHealthRestored is the HealthRestored event.
+type LidarrHealthRestored struct {
+	Message   string `env:"lidarr_health_restored_message"` // Lists unavailable due to failures: List name here
+	IssueType string `env:"lidarr_health_restored_type"`    // ImportListStatusCheck
+	Wiki      string `env:"lidarr_health_restored_wiki"`    // https://wiki.servarr.com/
+	Level     string `env:"lidarr_health_restored_level"`   // Warning
+}
+
 // LidarrGrab is the Grab event.
 type LidarrGrab struct {
-	DownloadClient string      `env:"lidarr_download_client"`             // Deluge
-	AlbumCount     int         `env:"lidarr_release_albumcount"`          // 1
-	Size           int64       `env:"lidarr_release_size"`                // 433061888
-	ReleaseDates   []time.Time `env:"lidarr_release_albumreleasedates,,"` // 4/21/2010 12:00:00 AM
-	ArtistID       int64       `env:"lidarr_artist_id"`                   // 262
-	ArtistName     string      `env:"lidarr_artist_name"`                 // Tom Petty and the Heartbreakers
-	MBID           string      `env:"lidarr_artist_mbid"`                 // f93dbc64-6f08-4033-bcc7-8a0bb4689849
-	Indexer        string      `env:"lidarr_release_indexer"`             // Indexilate (Prowlarr)
-	QualityVerson  int64       `env:"lidarr_release_qualityversion"`      // 1
-	Quality        string      `env:"lidarr_release_quality"`             // FLAC
-	ReleaseGroup   string      `env:"lidarr_release_releasegroup"`        //
-	ReleaseTitle   string      `env:"lidarr_release_title"`               // Tom Petty & The Heartbreakers - Mojo (2010) [FLAC (tracks + cue)]
-	AlbumMBIDs     []string    `env:"lidarr_release_albummbids,|"`        // 75f6f410-73e6-485b-898d-6fdaea4c0266
-	DownloadID     string      `env:"lidarr_download_id"`                 // 4A87D9F5F92D82DF4076463E90CC49F27077CB10
-	Titles         []string    `env:"lidarr_release_albumtitles,|"`       // Mojo
-	ArtistType     string      `env:"lidarr_artist_type"`                 // Group
+	DownloadClient    string      `env:"lidarr_download_client"`             // Deluge
+	AlbumCount        int         `env:"lidarr_release_albumcount"`          // 1
+	Size              int64       `env:"lidarr_release_size"`                // 433061888
+	ReleaseDates      []time.Time `env:"lidarr_release_albumreleasedates,,"` // 4/21/2010 12:00:00 AM
+	ArtistID          int64       `env:"lidarr_artist_id"`                   // 262
+	ArtistName        string      `env:"lidarr_artist_name"`                 // Tom Petty and the Heartbreakers
+	MBID              string      `env:"lidarr_artist_mbid"`                 // f93dbc64-6f08-4033-bcc7-8a0bb4689849
+	Indexer           string      `env:"lidarr_release_indexer"`             // Indexilate (Prowlarr)
+	QualityVerson     int64       `env:"lidarr_release_qualityversion"`      // 1
+	Quality           string      `env:"lidarr_release_quality"`             // FLAC
+	ReleaseGroup      string      `env:"lidarr_release_releasegroup"`        //
+	ReleaseTitle      string      `env:"lidarr_release_title"`               // Tom Petty & The Heartbreakers - Mojo (2010) [FLAC (tracks + cue)]
+	AlbumMBIDs        []string    `env:"lidarr_release_albummbids,|"`        // 75f6f410-73e6-485b-898d-6fdaea4c0266
+	DownloadID        string      `env:"lidarr_download_id"`                 // 4A87D9F5F92D82DF4076463E90CC49F27077CB10
+	Titles            []string    `env:"lidarr_release_albumtitles,|"`       // Mojo
+	ArtistType        string      `env:"lidarr_artist_type"`                 // Group
+	CustomFormats     []string    `env:"lidarr_release_customformat,|"`      // FLAC|Preferred Groups
+	CustomFormatScore int         `env:"lidarr_release_customformatscore"`   // 100
 }
 
 // LidarrAlbumDownload is the AlbumDownload event.
@@ -97,6 +110,47 @@ type LidarrTrackRetag struct {
 	TagsScrubbed     bool      `env:"lidarr_tags_scrubbed"`            // message.Scrubbed.ToString())
 }
 
+// LidarrArtistAdd is the ArtistAdd event.
+type LidarrArtistAdd struct {
+	InstanceName   string   `env:"lidarr_instancename"`    // Lidarr
+	ApplicationURL string   `env:"lidarr_applicationurl"`  // https://lidarr.example.com
+	ID             int64    `env:"lidarr_artist_id"`       // 262
+	Name           string   `env:"lidarr_artist_name"`     // Tom Petty and the Heartbreakers
+	Path           string   `env:"lidarr_artist_path"`     // /music/Tom Petty and the Heartbreakers
+	MBID           string   `env:"lidarr_artist_mbid"`     // f93dbc64-6f08-4033-bcc7-8a0bb4689849
+	ArtistType     string   `env:"lidarr_artist_type"`     // Group
+	Genres         []string `env:"lidarr_artist_genres,|"` // Rock|Heartland Rock
+	Tags           []string `env:"lidarr_artist_tags,|"`   // music|flac
+}
+
+// LidarrArtistDelete is the ArtistDelete event.
+type LidarrArtistDelete struct {
+	InstanceName   string `env:"lidarr_instancename"`        // Lidarr
+	ApplicationURL string `env:"lidarr_applicationurl"`      // https://lidarr.example.com
+	ID             int64  `env:"lidarr_artist_id"`           // 262
+	Name           string `env:"lidarr_artist_name"`         // Tom Petty and the Heartbreakers
+	Path           string `env:"lidarr_artist_path"`         // /music/Tom Petty and the Heartbreakers
+	MBID           string `env:"lidarr_artist_mbid"`         // f93dbc64-6f08-4033-bcc7-8a0bb4689849
+	ArtistType     string `env:"lidarr_artist_type"`         // Group
+	DeletedFiles   bool   `env:"lidarr_artist_deletedfiles"` // True
+}
+
+// LidarrAlbumDelete is the AlbumDelete event.
+type LidarrAlbumDelete struct {
+	InstanceName   string    `env:"lidarr_instancename"`       // Lidarr
+	ApplicationURL string    `env:"lidarr_applicationurl"`     // https://lidarr.example.com
+	ArtistID       int64     `env:"lidarr_artist_id"`          // 262
+	ArtistName     string    `env:"lidarr_artist_name"`        // Tom Petty and the Heartbreakers
+	Path           string    `env:"lidarr_artist_path"`        // /music/Tom Petty and the Heartbreakers
+	ArtistMBID     string    `env:"lidarr_artist_mbid"`        // f93dbc64-6f08-4033-bcc7-8a0bb4689849
+	ArtistType     string    `env:"lidarr_artist_type"`        // Group
+	ID             int64     `env:"lidarr_album_id"`           // 2131
+	Title          string    `env:"lidarr_album_title"`        // Mojo
+	MBID           string    `env:"lidarr_album_mbid"`         // 75f6f410-73e6-485b-898d-6fdaea4c0266
+	ReleaseDate    time.Time `env:"lidarr_album_releasedate"`  // 6/15/2010 12:00:00 AM
+	DeletedFiles   bool      `env:"lidarr_album_deletedfiles"` // False
+}
+
 // LidarrTest has no members.
 type LidarrTest struct{}
 
@@ -110,6 +164,11 @@ func (c *CmdEvent) GetLidarrHealthIssue() (output LidarrHealthIssue, err error)
 	return output, c.get(EventHealthIssue, &output)
 }
 
+// GetLidarrHealthRestored returns the HealthRestored event data.
+func (c *CmdEvent) GetLidarrHealthRestored() (output LidarrHealthRestored, err error) {
+	return output, c.get(EventHealthRestored, &output)
+}
+
 // GetLidarrGrab returns the Grab event data.
 func (c *CmdEvent) GetLidarrGrab() (output LidarrGrab, err error) {
 	return output, c.get(EventGrab, &output)
@@ -130,7 +189,154 @@ func (c *CmdEvent) GetLidarrTrackRetag() (output LidarrTrackRetag, err error) {
 	return output, c.get(EventTrackRetag, &output)
 }
 
+// GetLidarrArtistAdd returns the ArtistAdd event data.
+func (c *CmdEvent) GetLidarrArtistAdd() (output LidarrArtistAdd, err error) {
+	return output, c.get(EventArtistAdd, &output)
+}
+
+// GetLidarrArtistDelete returns the ArtistDelete event data.
+func (c *CmdEvent) GetLidarrArtistDelete() (output LidarrArtistDelete, err error) {
+	return output, c.get(EventArtistDelete, &output)
+}
+
+// GetLidarrAlbumDelete returns the AlbumDelete event data.
+func (c *CmdEvent) GetLidarrAlbumDelete() (output LidarrAlbumDelete, err error) {
+	return output, c.get(EventAlbumDelete, &output)
+}
+
 // GetLidarrTest returns the ApplicationUpdate event data.
 func (c *CmdEvent) GetLidarrTest() (output LidarrTest, err error) {
 	return output, c.get(EventTest, &output)
 }
+
+// OnLidarrApplicationUpdate registers a handler for the Lidarr ApplicationUpdate event.
+func (r *Router) OnLidarrApplicationUpdate(handler func(LidarrApplicationUpdate) error) {
+	r.Handle(starr.Lidarr, EventApplicationUpdate, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrApplicationUpdate()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrHealthIssue registers a handler for the Lidarr HealthIssue event.
+func (r *Router) OnLidarrHealthIssue(handler func(LidarrHealthIssue) error) {
+	r.Handle(starr.Lidarr, EventHealthIssue, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrHealthIssue()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrGrab registers a handler for the Lidarr Grab event.
+func (r *Router) OnLidarrGrab(handler func(LidarrGrab) error) {
+	r.Handle(starr.Lidarr, EventGrab, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrGrab()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrAlbumDownload registers a handler for the Lidarr AlbumDownload event.
+func (r *Router) OnLidarrAlbumDownload(handler func(LidarrAlbumDownload) error) {
+	r.Handle(starr.Lidarr, EventAlbumDownload, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrAlbumDownload()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrRename registers a handler for the Lidarr Rename event.
+func (r *Router) OnLidarrRename(handler func(LidarrRename) error) {
+	r.Handle(starr.Lidarr, EventRename, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrRename()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrTrackRetag registers a handler for the Lidarr TrackRetag event.
+func (r *Router) OnLidarrTrackRetag(handler func(LidarrTrackRetag) error) {
+	r.Handle(starr.Lidarr, EventTrackRetag, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrTrackRetag()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrTest registers a handler for the Lidarr Test event.
+func (r *Router) OnLidarrTest(handler func(LidarrTest) error) {
+	r.Handle(starr.Lidarr, EventTest, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrTest()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrHealthRestored registers a handler for the Lidarr HealthRestored event.
+func (r *Router) OnLidarrHealthRestored(handler func(LidarrHealthRestored) error) {
+	r.Handle(starr.Lidarr, EventHealthRestored, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrHealthRestored()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrArtistAdd registers a handler for the Lidarr ArtistAdd event.
+func (r *Router) OnLidarrArtistAdd(handler func(LidarrArtistAdd) error) {
+	r.Handle(starr.Lidarr, EventArtistAdd, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrArtistAdd()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrArtistDelete registers a handler for the Lidarr ArtistDelete event.
+func (r *Router) OnLidarrArtistDelete(handler func(LidarrArtistDelete) error) {
+	r.Handle(starr.Lidarr, EventArtistDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrArtistDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnLidarrAlbumDelete registers a handler for the Lidarr AlbumDelete event.
+func (r *Router) OnLidarrAlbumDelete(handler func(LidarrAlbumDelete) error) {
+	r.Handle(starr.Lidarr, EventAlbumDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetLidarrAlbumDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
@@ -207,3 +207,111 @@ func TestLidarrTrackRetag(t *testing.T) {
 		t.Fatalf("got wrong artists name? wanted: 'tushort' got: %s", info.ArtistName)
 	}
 }
+
+func TestLidarrHealthRestored(t *testing.T) {
+	t.Setenv("lidarr_eventtype", string(starrcmd.EventHealthRestored))
+	t.Setenv("lidarr_health_restored_type", "DownloadClientCheck")
+	t.Setenv("lidarr_health_restored_wiki", "https://wiki.servarr.com/lidarr/system")
+	t.Setenv("lidarr_health_restored_level", "Error")
+	t.Setenv("lidarr_health_restored_message", "Unable to communicate with qBittorrent")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetLidarrHealthRestored(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.Message != os.Getenv("lidarr_health_restored_message"):
+		t.Fatalf("got wrong Message? %s", info.Message)
+	case info.Wiki != "https://wiki.servarr.com/lidarr/system":
+		t.Fatalf("got wrong wiki link? wanted: 'https://wiki.servarr.com/lidarr/system' got: %s", info.Wiki)
+	case info.Level != "Error":
+		t.Fatalf("got wrong level? wanted: 'Error' got: %s", info.Level)
+	case info.IssueType != "DownloadClientCheck":
+		t.Fatalf("got wrong issue type? wanted: 'DownloadClientCheck' got: %s", info.IssueType)
+	}
+}
+
+func TestLidarrArtistAdd(t *testing.T) {
+	t.Setenv("lidarr_eventtype", string(starrcmd.EventArtistAdd))
+	t.Setenv("lidarr_artist_id", "262")
+	t.Setenv("lidarr_artist_name", "Tom Petty and the Heartbreakers")
+	t.Setenv("lidarr_artist_path", "/music/Tom Petty and the Heartbreakers")
+	t.Setenv("lidarr_artist_mbid", "f93dbc64-6f08-4033-bcc7-8a0bb4689849")
+	t.Setenv("lidarr_artist_type", "Group")
+	t.Setenv("lidarr_artist_genres", "Rock|Heartland Rock")
+	t.Setenv("lidarr_artist_tags", "music|flac")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetLidarrArtistAdd(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.ID != 262:
+		t.Fatalf("got wrong ID? wanted: 262 got: %d", info.ID)
+	case info.Name != "Tom Petty and the Heartbreakers":
+		t.Fatalf("got wrong name? got: %s", info.Name)
+	case info.MBID != "f93dbc64-6f08-4033-bcc7-8a0bb4689849":
+		t.Fatalf("got wrong MBID? got: %s", info.MBID)
+	case len(info.Genres) != 2 || info.Genres[1] != "Heartland Rock":
+		t.Fatalf("got wrong genres? wanted: [Rock Heartland Rock] got: %v", info.Genres)
+	case len(info.Tags) != 2 || info.Tags[1] != "flac":
+		t.Fatalf("got wrong tags? wanted: [music flac] got: %v", info.Tags)
+	}
+}
+
+func TestLidarrArtistDelete(t *testing.T) {
+	t.Setenv("lidarr_eventtype", string(starrcmd.EventArtistDelete))
+	t.Setenv("lidarr_artist_id", "262")
+	t.Setenv("lidarr_artist_name", "Tom Petty and the Heartbreakers")
+	t.Setenv("lidarr_artist_deletedfiles", "True")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetLidarrArtistDelete(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.ID != 262:
+		t.Fatalf("got wrong ID? wanted: 262 got: %d", info.ID)
+	case !info.DeletedFiles:
+		t.Fatalf("got wrong deleted files? wanted: true got: %v", info.DeletedFiles)
+	}
+}
+
+func TestLidarrAlbumDelete(t *testing.T) {
+	t.Setenv("lidarr_eventtype", string(starrcmd.EventAlbumDelete))
+	t.Setenv("lidarr_artist_id", "262")
+	t.Setenv("lidarr_album_id", "2131")
+	t.Setenv("lidarr_album_title", "Mojo")
+	t.Setenv("lidarr_album_mbid", "75f6f410-73e6-485b-898d-6fdaea4c0266")
+	t.Setenv("lidarr_album_releasedate", "6/15/2010 12:00:00 AM")
+	t.Setenv("lidarr_album_deletedfiles", "False")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetLidarrAlbumDelete(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.ArtistID != 262:
+		t.Fatalf("got wrong artist ID? wanted: 262 got: %d", info.ArtistID)
+	case info.ID != 2131:
+		t.Fatalf("got wrong ID? wanted: 2131 got: %d", info.ID)
+	case info.Title != "Mojo":
+		t.Fatalf("got wrong title? wanted: 'Mojo' got: %s", info.Title)
+	case info.ReleaseDate.Year() != 2010:
+		t.Fatalf("got wrong release date? wanted: 2010 got: %v", info.ReleaseDate)
+	case info.DeletedFiles:
+		t.Fatalf("got wrong deleted files? wanted: false got: %v", info.DeletedFiles)
+	}
+}
@@ -10,6 +10,15 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"golift.io/starr"
+)
+
+// These are the types the parser handles without looking at their kind.
+var (
+	timeType     = reflect.TypeOf(time.Time{})      //nolint:gochecknoglobals
+	durationType = reflect.TypeOf(time.Duration(0)) //nolint:gochecknoglobals
+	playTimeType = reflect.TypeOf(starr.PlayTime{}) //nolint:gochecknoglobals
 )
 
 // get offloads the error checking from all the other routines.
@@ -26,98 +35,121 @@ func (c *CmdEvent) get(wanted Event, output interface{}) error {
 	return nil
 }
 
-// This does not traverse structs and will only stay on normal members.
+// Unmarshal fills an event struct from a map of environment variables, like the one returned by Marshal.
+// Use this to parse an event that was saved, or that is not in the current environment.
+// Keys are not case sensitive. The output must be a pointer to a struct.
+func Unmarshal(env map[string]string, output interface{}) error {
+	lower := make(map[string]string, len(env))
+	for key, value := range env {
+		lower[strings.ToLower(key)] = value
+	}
+
+	return fillStruct(output, func(key string) string { return lower[key] })
+}
+
 func fillStructFromEnv(dataStruct interface{}) error {
+	return fillStruct(dataStruct, os.Getenv)
+}
+
+// fillStruct fills a struct pointer with values from the getenv function.
+func fillStruct(dataStruct interface{}, getenv func(string) string) error {
 	field := reflect.ValueOf(dataStruct)
-	if field.Kind() != reflect.Ptr || field.Elem().Kind() != reflect.Struct {
-		panic("yuh dun ate in sumthin bahd! This is a bug in the starrcmd library.")
+	if field.Kind() != reflect.Ptr || field.IsNil() || field.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %T", ErrInvalidData, dataStruct)
 	}
 
-	t := field.Type().Elem()
+	return fillStructMembers(field.Elem(), "", getenv)
+}
+
+// fillStructMembers fills every tagged member of a struct. Nested structs use their tag as a prefix
+// for the tags on their own members, ie. `env:"radarr_moviefile_mediainfo_"` and `env:"audiochannels"`.
+func fillStructMembers(field reflect.Value, prefix string, getenv func(string) string) error {
+	t := field.Type()
 	for idx := 0; idx < t.NumField(); idx++ { // Loop each struct member
 		split := strings.SplitN(t.Field(idx).Tag.Get("env"), ",", 2) //nolint:gomnd
 
 		tag := strings.ToLower(split[0]) // lower to protect naming mistakes.
-		if !field.Elem().Field(idx).CanSet() || tag == "-" || tag == "" {
+		if !field.Field(idx).CanSet() || tag == "-" || tag == "" {
 			continue // This only works with non-empty reflection tags on exported members.
 		}
 
+		if isNested(field.Field(idx).Type()) {
+			if err := fillStructMembers(field.Field(idx), prefix+tag, getenv); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		// If the tag has a comma, the value that follows is used to split strings into []string.
 		var splitVal string
 		if len(split) == 2 { //nolint:gomnd
 			splitVal = split[1]
 		}
 
-		value := os.Getenv(tag)
+		value := getenv(prefix + tag)
 		if value == "" {
-			// fmt.Println("skipping", tag)
 			continue
 		}
 
-		err := parseStructMember(field.Elem().Field(idx), value, splitVal)
-		if err != nil {
-			return fmt.Errorf("%s: (%s) %w", tag, os.Getenv(tag), err)
+		if err := parseStructMember(field.Field(idx), value, splitVal); err != nil {
+			return fmt.Errorf("%s: %w", prefix+tag, err)
 		}
 	}
 
 	return nil
 }
 
-/* All of the code below was taken from the golift.io/cnfg module. */
+// isNested returns true for struct members that contain more tagged members.
+func isNested(fieldType reflect.Type) bool {
+	return fieldType.Kind() == reflect.Struct && fieldType != timeType && fieldType != playTimeType
+}
+
+/* Some of the code below was taken from the golift.io/cnfg module. */
 
-// This is trimmed and does not parse some types.
+// parseStructMember parses a value into a struct member, slice element or map value.
 func parseStructMember(field reflect.Value, value, splitVal string) error { //nolint:cyclop
 	var err error
 
-	switch fieldType := field.Type().String(); fieldType {
-	// Handle each member type appropriately (differently).
-	case "string":
-		// SetString is a reflect package method to update a struct member by index.
+	switch fieldType := field.Type(); {
+	case fieldType == timeType:
+		var val time.Time
+
+		val, err = parseTime(value)
+		field.Set(reflect.ValueOf(val))
+	case fieldType == durationType:
+		var val time.Duration
+
+		val, err = parseDuration(value)
+		field.SetInt(int64(val))
+	case fieldType == playTimeType:
+		var val time.Duration
+
+		val, err = parseDuration(value)
+		field.Set(reflect.ValueOf(starr.PlayTime{Original: value, Duration: val}))
+	case fieldType.Kind() == reflect.String:
 		field.SetString(value)
-	case "int", "int64":
+	case fieldType.Kind() == reflect.Int || fieldType.Kind() == reflect.Int64:
 		var val int64
 
-		val, err = parseInt(fieldType, value)
+		val, err = strconv.ParseInt(strings.TrimSpace(value), 10, fieldType.Bits()) //nolint:gomnd
 		field.SetInt(val)
-		/*
-			case "float64":
-				// uncomment float64 if needed.
-				var val float64
-				//nolint:gomnd
-				val, err = strconv.ParseFloat(value, 64)
-				field.SetFloat(val)
-			case "time.Duration":
-				// this needs to be fixed to work with any duration values we find in starr apps.
-				var val time.Duration
-
-				val, err = time.ParseDuration(value)
-				field.Set(reflect.ValueOf(val))
-		*/
-	case "time.Time":
-		var val time.Time
-
-		if val, err = time.Parse(DateFormat, value); err != nil {
-			var err2 error
-			if val, err2 = time.Parse(DateFormat2, value); err2 != nil {
-				err = fmt.Errorf("error1: %v, error2: %w", err, err2) //nolint:errorlint
-			} else {
-				err = nil
-			}
-		}
+	case fieldType.Kind() == reflect.Float64:
+		var val float64
 
-		field.Set(reflect.ValueOf(val))
-	case "bool":
+		val, err = strconv.ParseFloat(strings.TrimSpace(value), 64) //nolint:gomnd
+		field.SetFloat(val)
+	case fieldType.Kind() == reflect.Bool:
 		var val bool
 
-		val, err = strconv.ParseBool(value)
+		val, err = strconv.ParseBool(strings.TrimSpace(value))
 		field.SetBool(val)
+	case fieldType.Kind() == reflect.Slice:
+		return parseSlice(field, value, splitVal)
+	case fieldType.Kind() == reflect.Map:
+		return parseMap(field, value, splitVal)
 	default:
-		if missing, err := parseSlices(field, value, splitVal); err != nil {
-			return fmt.Errorf("%s: %w", value, err)
-		} else if missing {
-			panic(fmt.Sprintf("invalid type provided to parser, this is a bug in the starrcmd library: %s (%s)",
-				fieldType, value))
-		}
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, fieldType)
 	}
 
 	if err != nil {
@@ -127,84 +159,121 @@ func parseStructMember(field reflect.Value, value, splitVal string) error { //no
 	return nil
 }
 
-func parseSlices(field reflect.Value, value, splitVal string) (bool, error) { //nolint:cyclop
+// parseSlice splits a value and parses each element into a slice.
+func parseSlice(field reflect.Value, value, splitVal string) error {
 	if splitVal == "" {
-		// this will trigger a panic() if you forget a splitVal on an env tag.
-		return true, nil
+		return fmt.Errorf("%w: %s", ErrNoSplit, field.Type())
 	}
 
-	var err error
+	split := strings.Split(value, splitVal)
+	vals := reflect.MakeSlice(field.Type(), len(split), len(split))
 
-	switch fieldType := field.Type().String(); fieldType {
-	default:
-		return true, nil
-	case "[]time.Time":
-		split := strings.Split(value, splitVal)
-		vals := make([]time.Time, len(split))
-
-		for idx, val := range split {
-			if vals[idx], err = time.Parse(DateFormat, val); err != nil {
-				if err != nil {
-					var err2 error
-					if vals[idx], err2 = time.Parse(DateFormat2, value); err2 != nil {
-						return false, fmt.Errorf("error1: %v, error2: %w", err, err2) //nolint:errorlint
-					}
-
-					err = nil
-				}
-			}
+	for idx, val := range split {
+		if err := parseStructMember(vals.Index(idx), val, ""); err != nil {
+			return fmt.Errorf("item %d: %w", idx, err)
 		}
+	}
 
-		field.Set(reflect.ValueOf(vals))
-	case "[]int":
-		split := strings.Split(value, splitVal)
-		vals := make([]int, len(split))
+	field.Set(vals)
 
-		for idx, val := range split {
-			if vals[idx], err = strconv.Atoi(val); err != nil {
-				return false, fmt.Errorf("%s: %w", value, err)
-			}
-		}
+	return nil
+}
 
-		field.Set(reflect.ValueOf(vals))
-	case "[]int64":
-		split := strings.Split(value, splitVal)
-		vals := make([]int64, len(split))
+// parseMap splits a value into key=value pairs and parses each value into a map with string keys.
+func parseMap(field reflect.Value, value, splitVal string) error {
+	if splitVal == "" {
+		return fmt.Errorf("%w: %s", ErrNoSplit, field.Type())
+	}
 
-		for idx, val := range split {
-			if vals[idx], err = parseInt(fieldType, val); err != nil {
-				return false, fmt.Errorf("%s: %w", value, err)
-			}
+	if field.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, field.Type())
+	}
+
+	vals := reflect.MakeMap(field.Type())
+
+	for _, pair := range strings.Split(value, splitVal) {
+		key, val, found := strings.Cut(pair, "=")
+		if !found {
+			return fmt.Errorf("%w: %s", ErrInvalidPair, pair)
+		}
+
+		item := reflect.New(field.Type().Elem()).Elem()
+		if err := parseStructMember(item, val, ""); err != nil {
+			return fmt.Errorf("key %s: %w", key, err)
 		}
 
-		field.Set(reflect.ValueOf(vals))
-	case "[]string":
-		vals := strings.Split(value, splitVal)
-		field.Set(reflect.ValueOf(vals))
+		vals.SetMapIndex(reflect.ValueOf(key).Convert(field.Type().Key()), item)
 	}
 
-	return false, err
-}
+	field.Set(vals)
 
-// parseInt parses an integer from a string as specific size.
-// If you need int8, 16 or 32, add them...
-func parseInt(intType, envval string) (int64, error) {
-	var (
-		val int64
-		err error
-	)
+	return nil
+}
 
-	//nolint:gomnd,nolintlint
-	switch intType {
-	default:
-		val, err = strconv.ParseInt(envval, 10, 0)
-	case "int64":
-		val, err = strconv.ParseInt(envval, 10, 64)
+// parseTime parses a date in either of the formats the apps use.
+func parseTime(value string) (time.Time, error) {
+	val, err := time.Parse(DateFormat, value)
+	if err == nil {
+		return val, nil
 	}
 
-	if err != nil { // this error may prove to suck...
-		return val, fmt.Errorf("parsing integer: %w", err)
+	val, err2 := time.Parse(DateFormat2, value)
+	if err2 != nil {
+		return val, fmt.Errorf("error1: %v, error2: %w", err, err2) //nolint:errorlint
 	}
 
 	return val, nil
 }
+
+// parseDuration parses a Go duration (1h2m3s), or a C# TimeSpan (d.hh:mm:ss.fffffff, hh:mm:ss, mm:ss or ss).
+// The TimeSpan formats are parsed like starr.PlayTime.
+func parseDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if dur, err := time.ParseDuration(value); err == nil {
+		return dur, nil
+	}
+
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	var days time.Duration
+
+	// A TimeSpan longer than a day has a days prefix, ie. 1.02:03:04
+	if before, after, found := strings.Cut(value, "."); found && strings.Contains(after, ":") {
+		day, err := strconv.ParseUint(before, 10, 16) //nolint:gomnd
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidDuration, value)
+		}
+
+		days, value = time.Duration(day)*24*time.Hour, after //nolint:gomnd
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 { //nolint:gomnd // hh:mm:ss
+		return 0, fmt.Errorf("%w: %s", ErrInvalidDuration, value)
+	}
+
+	// The last part is seconds, and may have a fraction. The others are minutes, then hours.
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64) //nolint:gomnd
+	if err != nil || !(seconds >= 0 && seconds <= 1e9) {        // This also catches NaN.
+		return 0, fmt.Errorf("%w: %s", ErrInvalidDuration, value)
+	}
+
+	dur := days + time.Duration(seconds*float64(time.Second))
+	units := []time.Duration{time.Minute, time.Hour}
+
+	for idx := len(parts) - 2; idx >= 0; idx-- { //nolint:gomnd
+		val, err := strconv.ParseUint(parts[idx], 10, 16) //nolint:gomnd
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidDuration, value)
+		}
+
+		dur += time.Duration(val) * units[len(parts)-2-idx]
+	}
+
+	if negative {
+		return -dur, nil
+	}
+
+	return dur, nil
+}
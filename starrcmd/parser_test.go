@@ -0,0 +1,237 @@
+package starrcmd_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/starrcmd"
+)
+
+// testMediaInfo is a nested struct. Its tags are prefixed with the parent member's tag.
+type testMediaInfo struct {
+	AudioChannels float64       `env:"audiochannels"`
+	Languages     []string      `env:"audiolanguages,/"`
+	RunTime       time.Duration `env:"runtime"`
+}
+
+// testEvent has a member with every type the parser handles.
+type testEvent struct {
+	String    string            `env:"test_string"`
+	Int       int               `env:"test_int"`
+	Int64     int64             `env:"test_int64"`
+	Float     float64           `env:"test_float"`
+	Bool      bool              `env:"test_bool"`
+	Time      time.Time         `env:"test_time"`
+	Duration  time.Duration     `env:"test_duration"`
+	PlayTime  starr.PlayTime    `env:"test_playtime"`
+	Strings   []string          `env:"test_strings,|"`
+	Ints      []int             `env:"test_ints,,"`
+	Int64s    []int64           `env:"test_int64s,,"`
+	Bools     []bool            `env:"test_bools,,"`
+	Floats    []float64         `env:"test_floats,,"`
+	Durations []time.Duration   `env:"test_durations,,"`
+	Times     []time.Time       `env:"test_times,|"`
+	Map       map[string]string `env:"test_map,|"`
+	IntMap    map[string]int64  `env:"test_intmap,,"`
+	MediaInfo testMediaInfo     `env:"test_mediainfo_"`
+	Ignored   string            `env:"-"`
+	unexport  string            `env:"test_unexported"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var event testEvent
+
+	err := starrcmd.Unmarshal(map[string]string{
+		"test_string":                    "value",
+		"TEST_INT":                       "-12",
+		"test_int64":                     "9000000000",
+		"test_float":                     "5.1",
+		"test_bool":                      "True",
+		"test_time":                      "1/26/2022 2:00:00 AM",
+		"test_duration":                  "1.02:03:04.5",
+		"test_playtime":                  "44:05",
+		"test_strings":                   "one|two",
+		"test_ints":                      "1,2,3",
+		"test_int64s":                    "9000000000,4",
+		"test_bools":                     "True,false,1",
+		"test_floats":                    "1.5,2",
+		"test_durations":                 "1h30m,00:00:45",
+		"test_times":                     "1/26/2022 2:00:00 AM|07/10/2003 07:00:00",
+		"test_map":                       "a=1|b=c=d",
+		"test_intmap":                    "x=1,y=2",
+		"test_mediainfo_audiochannels":   "7.1",
+		"test_mediainfo_audiolanguages":  "English/Spanish",
+		"test_mediainfo_runtime":         "01:58:12",
+		"test_unexported":                "nope",
+		"test_mediainfo_notamember_here": "ignored",
+	}, &event)
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	wanted := testEvent{
+		String:    "value",
+		Int:       -12,
+		Int64:     9000000000,
+		Float:     5.1,
+		Bool:      true,
+		Time:      time.Date(2022, 1, 26, 2, 0, 0, 0, time.UTC),
+		Duration:  26*time.Hour + 3*time.Minute + 4*time.Second + 500*time.Millisecond,
+		PlayTime:  starr.PlayTime{Original: "44:05", Duration: 44*time.Minute + 5*time.Second},
+		Strings:   []string{"one", "two"},
+		Ints:      []int{1, 2, 3},
+		Int64s:    []int64{9000000000, 4},
+		Bools:     []bool{true, false, true},
+		Floats:    []float64{1.5, 2},
+		Durations: []time.Duration{90 * time.Minute, 45 * time.Second},
+		Times:     []time.Time{time.Date(2022, 1, 26, 2, 0, 0, 0, time.UTC), time.Date(2003, 7, 10, 7, 0, 0, 0, time.UTC)},
+		Map:       map[string]string{"a": "1", "b": "c=d"},
+		IntMap:    map[string]int64{"x": 1, "y": 2},
+		MediaInfo: testMediaInfo{
+			AudioChannels: 7.1,
+			Languages:     []string{"English", "Spanish"},
+			RunTime:       time.Hour + 58*time.Minute + 12*time.Second,
+		},
+	}
+
+	if !reflect.DeepEqual(wanted, event) {
+		t.Fatalf("got wrong data:\nwanted: %+v\n   got: %+v", wanted, event)
+	}
+
+	// It goes back the same way.
+	cmd := &starrcmd.CmdEvent{App: starr.Sonarr, Type: starrcmd.EventTest}
+
+	env, err := cmd.Marshal(&wanted)
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	if env["test_duration"] != "1.02:03:04.5000000" || env["test_map"] != "a=1|b=c=d" ||
+		env["test_mediainfo_audiochannels"] != "7.1" || env["test_bools"] != "True,False,True" {
+		t.Fatalf("got wrong environment: %v", env)
+	}
+
+	event = testEvent{}
+	if err = starrcmd.Unmarshal(env, &event); err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(wanted, event) {
+		t.Fatalf("got wrong data after round trip:\nwanted: %+v\n   got: %+v", wanted, event)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		key   string
+		value string
+		err   error
+	}{
+		{key: "test_int", value: "1.5", err: nil},
+		{key: "test_float", value: "abc", err: nil},
+		{key: "test_bool", value: "yes", err: nil},
+		{key: "test_time", value: "2022-01-26", err: nil},
+		{key: "test_duration", value: "1:2:3:4", err: starrcmd.ErrInvalidDuration},
+		{key: "test_duration", value: "NaN", err: starrcmd.ErrInvalidDuration},
+		{key: "test_ints", value: "1,,3", err: nil},
+		{key: "test_map", value: "a=1|b", err: starrcmd.ErrInvalidPair},
+		{key: "test_intmap", value: "x=y", err: nil},
+		{key: "test_mediainfo_runtime", value: "forever", err: starrcmd.ErrInvalidDuration},
+	}
+
+	for _, test := range tests {
+		var event testEvent
+
+		err := starrcmd.Unmarshal(map[string]string{test.key: test.value}, &event)
+		if err == nil {
+			t.Errorf("%s=%s: expected an error", test.key, test.value)
+		} else if test.err != nil && !errors.Is(err, test.err) {
+			t.Errorf("%s=%s: got wrong error? wanted: %v got: %v", test.key, test.value, test.err, err)
+		}
+	}
+
+	var bad struct {
+		NoSplit []string `env:"test_nosplit"`
+		Small   int32    `env:"test_small"`
+	}
+
+	if err := starrcmd.Unmarshal(map[string]string{"test_nosplit": "a,b"}, &bad); !errors.Is(err, starrcmd.ErrNoSplit) {
+		t.Errorf("got wrong error? wanted: %v got: %v", starrcmd.ErrNoSplit, err)
+	}
+
+	if err := starrcmd.Unmarshal(map[string]string{"test_small": "1"}, &bad); !errors.Is(err, starrcmd.ErrUnsupportedType) {
+		t.Errorf("got wrong error? wanted: %v got: %v", starrcmd.ErrUnsupportedType, err)
+	}
+
+	if err := starrcmd.Unmarshal(nil, bad); !errors.Is(err, starrcmd.ErrInvalidData) {
+		t.Errorf("got wrong error? wanted: %v got: %v", starrcmd.ErrInvalidData, err)
+	}
+}
+
+// FuzzUnmarshal proves the parser does not panic on any input.
+func FuzzUnmarshal(f *testing.F) {
+	for _, seed := range []string{
+		"", "1", "-1", "1.5", "True", "1/26/2022 2:00:00 AM", "07/10/2003 07:00:00", "1.02:03:04.5", "1h2m",
+		"a=1|b=2", "1,2,3", "::", "-:-", "1.:", "99999999999999999999", "NaN", "Inf", "=", "||", ",,",
+	} {
+		f.Add(seed)
+	}
+
+	keys := []string{
+		"test_string", "test_int", "test_int64", "test_float", "test_bool", "test_time", "test_duration",
+		"test_playtime", "test_strings", "test_ints", "test_int64s", "test_bools", "test_floats", "test_durations",
+		"test_times", "test_map", "test_intmap", "test_mediainfo_audiochannels", "test_mediainfo_audiolanguages",
+		"test_mediainfo_runtime",
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		env := make(map[string]string, len(keys))
+		for _, key := range keys {
+			env[key] = value
+		}
+
+		var event testEvent
+		_ = starrcmd.Unmarshal(env, &event)
+
+		for _, key := range keys {
+			_ = starrcmd.Unmarshal(map[string]string{key: value}, &event)
+		}
+	})
+}
+
+// FuzzRoundTrip proves that anything the parser reads is written back, and read again, the same way.
+func FuzzRoundTrip(f *testing.F) {
+	f.Add("1,2", "a=1|b=2", "01:02:03")
+	f.Add("", "=", "1.00:00:00.0000001")
+	f.Add("", "", "1ns")
+
+	f.Fuzz(func(t *testing.T, ints, pairs, duration string) {
+		var first testEvent
+
+		env := map[string]string{"test_int64s": ints, "test_map": pairs, "test_duration": duration}
+		if starrcmd.Unmarshal(env, &first) != nil {
+			return // Invalid input is fine, as long as it doesn't panic.
+		}
+
+		env, err := (&starrcmd.CmdEvent{}).Marshal(&first)
+		if err != nil {
+			t.Fatalf("got an unexpected error: %s", err)
+		}
+
+		var second testEvent
+		if err = starrcmd.Unmarshal(env, &second); err != nil {
+			t.Fatalf("got an unexpected error: %s: %v", err, env)
+		}
+
+		if !reflect.DeepEqual(first, second) {
+			t.Fatalf("got wrong data after round trip:\nfirst: %+v\nsecond: %+v", first, second)
+		}
+	})
+}
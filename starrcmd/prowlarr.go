@@ -2,9 +2,12 @@ package starrcmd
 
 /*
 Prowlarr only has 3 events, all accounted for; 1/30/2022.
+Added HealthRestored.
 https://github.com/Prowlarr/Prowlarr/blob/develop/src/NzbDrone.Core/Notifications/CustomScript/CustomScript.cs
 */
 
+import "golift.io/starr"
+
 // ProwlarrApplicationUpdate is the ApplicationUpdate event.
 type ProwlarrApplicationUpdate struct {
 	PreviousVersion string `env:"prowlarr_update_previousversion"` // 4.0.3.5875
@@ -20,6 +23,14 @@ type ProwlarrHealthIssue struct {
 	Level     string `env:"prowlarr_health_issue_level"`   // Warning
 }
 
+// ProwlarrHealthRestored is the HealthRestored event.
+type ProwlarrHealthRestored struct {
+	Message   string `env:"prowlarr_health_restored_message"` // Lists unavailable due to failures: List name here
+	IssueType string `env:"prowlarr_health_restored_type"`    // ImportListStatusCheck
+	Wiki      string `env:"prowlarr_health_restored_wiki"`    // https://wiki.servarr.com/
+	Level     string `env:"prowlarr_health_restored_level"`   // Warning
+}
+
 // ProwlarrTest has no members.
 type ProwlarrTest struct{}
 
@@ -33,7 +44,60 @@ func (c *CmdEvent) GetProwlarrHealthIssue() (output ProwlarrHealthIssue, err err
 	return output, c.get(EventHealthIssue, &output)
 }
 
+// GetProwlarrHealthRestored returns the HealthRestored event data.
+func (c *CmdEvent) GetProwlarrHealthRestored() (output ProwlarrHealthRestored, err error) {
+	return output, c.get(EventHealthRestored, &output)
+}
+
 // GetProwlarrTest returns the ApplicationUpdate event data.
 func (c *CmdEvent) GetProwlarrTest() (output ProwlarrTest, err error) {
 	return output, c.get(EventTest, &output)
 }
+
+// OnProwlarrApplicationUpdate registers a handler for the Prowlarr ApplicationUpdate event.
+func (r *Router) OnProwlarrApplicationUpdate(handler func(ProwlarrApplicationUpdate) error) {
+	r.Handle(starr.Prowlarr, EventApplicationUpdate, func(cmd *CmdEvent) error {
+		event, err := cmd.GetProwlarrApplicationUpdate()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnProwlarrHealthIssue registers a handler for the Prowlarr HealthIssue event.
+func (r *Router) OnProwlarrHealthIssue(handler func(ProwlarrHealthIssue) error) {
+	r.Handle(starr.Prowlarr, EventHealthIssue, func(cmd *CmdEvent) error {
+		event, err := cmd.GetProwlarrHealthIssue()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnProwlarrTest registers a handler for the Prowlarr Test event.
+func (r *Router) OnProwlarrTest(handler func(ProwlarrTest) error) {
+	r.Handle(starr.Prowlarr, EventTest, func(cmd *CmdEvent) error {
+		event, err := cmd.GetProwlarrTest()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnProwlarrHealthRestored registers a handler for the Prowlarr HealthRestored event.
+func (r *Router) OnProwlarrHealthRestored(handler func(ProwlarrHealthRestored) error) {
+	r.Handle(starr.Prowlarr, EventHealthRestored, func(cmd *CmdEvent) error {
+		event, err := cmd.GetProwlarrHealthRestored()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
@@ -72,3 +72,29 @@ func TestProwlarrTest(t *testing.T) {
 		t.Fatalf("got an wrong structure in return")
 	}
 }
+
+func TestProwlarrHealthRestored(t *testing.T) {
+	t.Setenv("prowlarr_eventtype", string(starrcmd.EventHealthRestored))
+	t.Setenv("prowlarr_health_restored_type", "DownloadClientCheck")
+	t.Setenv("prowlarr_health_restored_wiki", "https://wiki.servarr.com/prowlarr/system")
+	t.Setenv("prowlarr_health_restored_level", "Error")
+	t.Setenv("prowlarr_health_restored_message", "Unable to communicate with qBittorrent")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetProwlarrHealthRestored(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.Message != os.Getenv("prowlarr_health_restored_message"):
+		t.Fatalf("got wrong Message? %s", info.Message)
+	case info.Wiki != "https://wiki.servarr.com/prowlarr/system":
+		t.Fatalf("got wrong wiki link? wanted: 'https://wiki.servarr.com/prowlarr/system' got: %s", info.Wiki)
+	case info.Level != "Error":
+		t.Fatalf("got wrong level? wanted: 'Error' got: %s", info.Level)
+	case info.IssueType != "DownloadClientCheck":
+		t.Fatalf("got wrong issue type? wanted: 'DownloadClientCheck' got: %s", info.IssueType)
+	}
+}
@@ -3,11 +3,14 @@ package starrcmd
 
 /*
 All events accounted for; 1/30/2022
+Added HealthRestored, MovieAdded and ManualInteractionRequired, and custom format variables on Grab.
 https://github.com/Radarr/Radarr/blob/develop/src/NzbDrone.Core/Notifications/CustomScript/CustomScript.cs
 */
 
 import (
 	"time"
+
+	"golift.io/starr"
 )
 
 // RadarrApplicationUpdate is the ApplicationUpdate event.
@@ -43,24 +46,35 @@ type RadarrDownload struct {
 	DeletedPaths         []string  `env:"radarr_deletedpaths,|"`
 }
 
+// RadarrHealthRestored is the HealthRestored event.
+type RadarrHealthRestored struct {
+	Message   string `env:"radarr_health_restored_message"` // Lists unavailable due to failures: List name here
+	IssueType string `env:"radarr_health_restored_type"`    // ImportListStatusCheck
+	Wiki      string `env:"radarr_health_restored_wiki"`    // https://wiki.servarr.com/
+	Level     string `env:"radarr_health_restored_level"`   // Warning
+}
+
 // RadarrGrab is the Grab event.
 type RadarrGrab struct {
-	QualityVersion int64     `env:"radarr_release_qualityversion"`      // 1
-	ReleaseDate    time.Time `env:"radarr_movie_physical_release_date"` // 1/19/2006 12:00:00 AM
-	ReleaseGroup   string    `env:"radarr_release_releasegroup"`        // SLOT
-	IndexerFlags   int64     `env:"radarr_indexerflags"`                // 0
-	IMDbID         string    `env:"radarr_movie_imdbid"`                // tt0448172
-	DownloadID     string    `env:"radarr_download_id"`                 // E63FAFFAAA0DEE42F0846348A9C0657BC53E7AA5
-	ReleaseTitle   string    `env:"radarr_release_title"`               // 8MM 2 2005 1080p BluRay x264
-	InCinemas      time.Time `env:"radarr_movie_in_cinemas_date"`       // 11/22/2005 12:00:00 AM
-	Quality        string    `env:"radarr_release_quality"`             // Bluray-1080p
-	Size           int64     `env:"radarr_release_size"`                // 2158221056
-	Year           int       `env:"radarr_movie_year"`                  // 2005
-	DownloadClient string    `env:"radarr_download_client"`             // Deluge
-	TMDbID         int64     `env:"radarr_movie_tmdbid"`                // 7295
-	ID             int64     `env:"radarr_movie_id"`                    // 339
-	ReleaseIndexer string    `env:"radarr_release_indexer"`             // Inexilator (Prowlarr)
-	Title          string    `env:"radarr_movie_title"`                 // 8MM 2
+	QualityVersion     int64     `env:"radarr_release_qualityversion"`      // 1
+	ReleaseDate        time.Time `env:"radarr_movie_physical_release_date"` // 1/19/2006 12:00:00 AM
+	ReleaseGroup       string    `env:"radarr_release_releasegroup"`        // SLOT
+	IndexerFlags       int64     `env:"radarr_indexerflags"`                // 0
+	IMDbID             string    `env:"radarr_movie_imdbid"`                // tt0448172
+	DownloadID         string    `env:"radarr_download_id"`                 // E63FAFFAAA0DEE42F0846348A9C0657BC53E7AA5
+	ReleaseTitle       string    `env:"radarr_release_title"`               // 8MM 2 2005 1080p BluRay x264
+	InCinemas          time.Time `env:"radarr_movie_in_cinemas_date"`       // 11/22/2005 12:00:00 AM
+	Quality            string    `env:"radarr_release_quality"`             // Bluray-1080p
+	Size               int64     `env:"radarr_release_size"`                // 2158221056
+	Year               int       `env:"radarr_movie_year"`                  // 2005
+	DownloadClient     string    `env:"radarr_download_client"`             // Deluge
+	TMDbID             int64     `env:"radarr_movie_tmdbid"`                // 7295
+	ID                 int64     `env:"radarr_movie_id"`                    // 339
+	ReleaseIndexer     string    `env:"radarr_release_indexer"`             // Inexilator (Prowlarr)
+	Title              string    `env:"radarr_movie_title"`                 // 8MM 2
+	CustomFormats      []string  `env:"radarr_release_customformat,|"`      // x264|Bluray Tier 02
+	CustomFormatScore  int       `env:"radarr_release_customformatscore"`   // 1500
+	DownloadClientType string    `env:"radarr_download_client_type"`        // Deluge
 }
 
 // RadarrHealthIssue is the HealthIssue event.
@@ -118,6 +132,45 @@ type RadarrRename struct {
 	PreviousPaths         []string  `env:"radarr_moviefile_previouspaths,|"`
 }
 
+// RadarrMovieAdded is the MovieAdded event.
+type RadarrMovieAdded struct {
+	InstanceName   string    `env:"radarr_instancename"`                // Radarr
+	ApplicationURL string    `env:"radarr_applicationurl"`              // https://radarr.example.com
+	ID             int64     `env:"radarr_movie_id"`                    // 2173
+	Title          string    `env:"radarr_movie_title"`                 // The French Dispatch
+	Year           int       `env:"radarr_movie_year"`                  // 2021
+	Path           string    `env:"radarr_movie_path"`                  // /movies/The French Dispatch (2021)
+	IMDbID         string    `env:"radarr_movie_imdbid"`                // tt8847712
+	TMDbID         int64     `env:"radarr_movie_tmdbid"`                // 542178
+	Overview       string    `env:"radarr_movie_overview"`              // The French Dispatch brings to life a collection of stories...
+	Genres         []string  `env:"radarr_movie_genres,|"`              // Comedy|Drama|Romance
+	Tags           []string  `env:"radarr_movie_tags,|"`                // movies|4k
+	InCinemas      time.Time `env:"radarr_movie_in_cinemas_date"`       // 10/21/2021 12:00:00 AM
+	ReleaseDate    time.Time `env:"radarr_movie_physical_release_date"` // 12/21/2021 12:00:00 AM
+	AddMethod      string    `env:"radarr_movie_addmethod"`             // Manual
+}
+
+// RadarrManualInteractionRequired is the ManualInteractionRequired event.
+type RadarrManualInteractionRequired struct {
+	InstanceName       string    `env:"radarr_instancename"`                // Radarr
+	ApplicationURL     string    `env:"radarr_applicationurl"`              // https://radarr.example.com
+	ID                 int64     `env:"radarr_movie_id"`                    // 339
+	Title              string    `env:"radarr_movie_title"`                 // 8MM 2
+	Year               int       `env:"radarr_movie_year"`                  // 2005
+	Path               string    `env:"radarr_movie_path"`                  // /movies/8MM 2 (2005)
+	IMDbID             string    `env:"radarr_movie_imdbid"`                // tt0448172
+	TMDbID             int64     `env:"radarr_movie_tmdbid"`                // 7295
+	InCinemas          time.Time `env:"radarr_movie_in_cinemas_date"`       // 11/22/2005 12:00:00 AM
+	ReleaseDate        time.Time `env:"radarr_movie_physical_release_date"` // 1/19/2006 12:00:00 AM
+	DownloadClient     string    `env:"radarr_download_client"`             // Deluge
+	DownloadClientType string    `env:"radarr_download_client_type"`        // Deluge
+	DownloadID         string    `env:"radarr_download_id"`                 // E63FAFFAAA0DEE42F0846348A9C0657BC53E7AA5
+	DownloadSize       int64     `env:"radarr_download_size"`               // 2158221056
+	DownloadTitle      string    `env:"radarr_download_title"`              // 8MM 2 2005 1080p BluRay x264
+	CustomFormats      []string  `env:"radarr_release_customformat,|"`      // x264|Bluray Tier 02
+	CustomFormatScore  int       `env:"radarr_release_customformatscore"`   // 1500
+}
+
 // RadarrTest has no members.
 type RadarrTest struct{}
 
@@ -126,6 +179,11 @@ func (c *CmdEvent) GetRadarrHealthIssue() (output RadarrHealthIssue, err error)
 	return output, c.get(EventHealthIssue, &output)
 }
 
+// GetRadarrHealthRestored returns the HealthRestored event data.
+func (c *CmdEvent) GetRadarrHealthRestored() (output RadarrHealthRestored, err error) {
+	return output, c.get(EventHealthRestored, &output)
+}
+
 // GetRadarrApplicationUpdate returns the ApplicationUpdate event data.
 func (c *CmdEvent) GetRadarrApplicationUpdate() (output RadarrApplicationUpdate, err error) {
 	return output, c.get(EventApplicationUpdate, &output)
@@ -156,7 +214,149 @@ func (c *CmdEvent) GetRadarrMovieDelete() (output RadarrMovieDelete, err error)
 	return output, c.get(EventMovieDelete, &output)
 }
 
+// GetRadarrMovieAdded returns the MovieAdded event data.
+func (c *CmdEvent) GetRadarrMovieAdded() (output RadarrMovieAdded, err error) {
+	return output, c.get(EventMovieAdded, &output)
+}
+
 // GetRadarrRename returns the Rename event data.
 func (c *CmdEvent) GetRadarrRename() (output RadarrRename, err error) {
 	return output, c.get(EventRename, &output)
 }
+
+// GetRadarrManualInteractionRequired returns the ManualInteractionRequired event data.
+func (c *CmdEvent) GetRadarrManualInteractionRequired() (output RadarrManualInteractionRequired, err error) {
+	return output, c.get(EventManualInteractionRequired, &output)
+}
+
+// OnRadarrHealthIssue registers a handler for the Radarr HealthIssue event.
+func (r *Router) OnRadarrHealthIssue(handler func(RadarrHealthIssue) error) {
+	r.Handle(starr.Radarr, EventHealthIssue, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrHealthIssue()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrApplicationUpdate registers a handler for the Radarr ApplicationUpdate event.
+func (r *Router) OnRadarrApplicationUpdate(handler func(RadarrApplicationUpdate) error) {
+	r.Handle(starr.Radarr, EventApplicationUpdate, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrApplicationUpdate()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrDownload registers a handler for the Radarr Download event.
+func (r *Router) OnRadarrDownload(handler func(RadarrDownload) error) {
+	r.Handle(starr.Radarr, EventDownload, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrDownload()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrGrab registers a handler for the Radarr Grab event.
+func (r *Router) OnRadarrGrab(handler func(RadarrGrab) error) {
+	r.Handle(starr.Radarr, EventGrab, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrGrab()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrMovieFileDelete registers a handler for the Radarr MovieFileDelete event.
+func (r *Router) OnRadarrMovieFileDelete(handler func(RadarrMovieFileDelete) error) {
+	r.Handle(starr.Radarr, EventMovieFileDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrMovieFileDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrTest registers a handler for the Radarr Test event.
+func (r *Router) OnRadarrTest(handler func(RadarrTest) error) {
+	r.Handle(starr.Radarr, EventTest, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrTest()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrMovieDelete registers a handler for the Radarr MovieDelete event.
+func (r *Router) OnRadarrMovieDelete(handler func(RadarrMovieDelete) error) {
+	r.Handle(starr.Radarr, EventMovieDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrMovieDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrRename registers a handler for the Radarr Rename event.
+func (r *Router) OnRadarrRename(handler func(RadarrRename) error) {
+	r.Handle(starr.Radarr, EventRename, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrRename()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrHealthRestored registers a handler for the Radarr HealthRestored event.
+func (r *Router) OnRadarrHealthRestored(handler func(RadarrHealthRestored) error) {
+	r.Handle(starr.Radarr, EventHealthRestored, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrHealthRestored()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrMovieAdded registers a handler for the Radarr MovieAdded event.
+func (r *Router) OnRadarrMovieAdded(handler func(RadarrMovieAdded) error) {
+	r.Handle(starr.Radarr, EventMovieAdded, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrMovieAdded()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnRadarrManualInteractionRequired registers a handler for the Radarr ManualInteractionRequired event.
+func (r *Router) OnRadarrManualInteractionRequired(handler func(RadarrManualInteractionRequired) error) {
+	r.Handle(starr.Radarr, EventManualInteractionRequired, func(cmd *CmdEvent) error {
+		event, err := cmd.GetRadarrManualInteractionRequired()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
@@ -272,3 +272,104 @@ func TestRadarrMovieDelete(t *testing.T) {
 		t.Fatalf("got an wrong IMDBID? wanted: 'tt1564397', got: %v", info.IMDbID)
 	}
 }
+
+func TestRadarrHealthRestored(t *testing.T) {
+	t.Setenv("radarr_eventtype", string(starrcmd.EventHealthRestored))
+	t.Setenv("radarr_health_restored_type", "DownloadClientCheck")
+	t.Setenv("radarr_health_restored_wiki", "https://wiki.servarr.com/radarr/system")
+	t.Setenv("radarr_health_restored_level", "Error")
+	t.Setenv("radarr_health_restored_message", "Unable to communicate with qBittorrent")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetRadarrHealthRestored(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.Message != os.Getenv("radarr_health_restored_message"):
+		t.Fatalf("got wrong Message? %s", info.Message)
+	case info.Wiki != "https://wiki.servarr.com/radarr/system":
+		t.Fatalf("got wrong wiki link? wanted: 'https://wiki.servarr.com/radarr/system' got: %s", info.Wiki)
+	case info.Level != "Error":
+		t.Fatalf("got wrong level? wanted: 'Error' got: %s", info.Level)
+	case info.IssueType != "DownloadClientCheck":
+		t.Fatalf("got wrong issue type? wanted: 'DownloadClientCheck' got: %s", info.IssueType)
+	}
+}
+
+func TestRadarrMovieAdded(t *testing.T) {
+	t.Setenv("radarr_eventtype", string(starrcmd.EventMovieAdded))
+	t.Setenv("radarr_instancename", "Radarr")
+	t.Setenv("radarr_applicationurl", "https://radarr.example.com")
+	t.Setenv("radarr_movie_id", "2173")
+	t.Setenv("radarr_movie_title", "The French Dispatch")
+	t.Setenv("radarr_movie_year", "2021")
+	t.Setenv("radarr_movie_path", "/movies/The French Dispatch (2021)")
+	t.Setenv("radarr_movie_imdbid", "tt8847712")
+	t.Setenv("radarr_movie_tmdbid", "542178")
+	t.Setenv("radarr_movie_overview", "A love letter to journalists set in an outpost of an American newspaper.")
+	t.Setenv("radarr_movie_genres", "Comedy|Drama|Romance")
+	t.Setenv("radarr_movie_tags", "movies")
+	t.Setenv("radarr_movie_in_cinemas_date", "10/21/2021 12:00:00 AM")
+	t.Setenv("radarr_movie_physical_release_date", "12/21/2021 12:00:00 AM")
+	t.Setenv("radarr_movie_addmethod", "List")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetRadarrMovieAdded(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.ApplicationURL != "https://radarr.example.com":
+		t.Fatalf("got wrong application url? wanted: 'https://radarr.example.com' got: %s", info.ApplicationURL)
+	case info.TMDbID != 542178:
+		t.Fatalf("got wrong TMDb ID? wanted: 542178 got: %d", info.TMDbID)
+	case info.Year != 2021:
+		t.Fatalf("got wrong year? wanted: 2021 got: %d", info.Year)
+	case len(info.Genres) != 3 || info.Genres[0] != "Comedy":
+		t.Fatalf("got wrong genres? wanted: [Comedy Drama Romance] got: %v", info.Genres)
+	case len(info.Tags) != 1 || info.Tags[0] != "movies":
+		t.Fatalf("got wrong tags? wanted: [movies] got: %v", info.Tags)
+	case info.ReleaseDate.Month() != 12:
+		t.Fatalf("got wrong release date? wanted: December got: %v", info.ReleaseDate)
+	case info.AddMethod != "List":
+		t.Fatalf("got wrong add method? wanted: 'List' got: %s", info.AddMethod)
+	}
+}
+
+func TestRadarrManualInteractionRequired(t *testing.T) {
+	t.Setenv("radarr_eventtype", string(starrcmd.EventManualInteractionRequired))
+	t.Setenv("radarr_movie_id", "339")
+	t.Setenv("radarr_movie_title", "8MM 2")
+	t.Setenv("radarr_download_client", "Deluge")
+	t.Setenv("radarr_download_client_type", "Deluge")
+	t.Setenv("radarr_download_id", "E63FAFFAAA0DEE42F0846348A9C0657BC53E7AA5")
+	t.Setenv("radarr_download_size", "2158221056")
+	t.Setenv("radarr_download_title", "8MM 2 2005 1080p BluRay x264")
+	t.Setenv("radarr_release_customformat", "x264|Bluray Tier 02")
+	t.Setenv("radarr_release_customformatscore", "1500")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetRadarrManualInteractionRequired(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.ID != 339:
+		t.Fatalf("got wrong ID? wanted: 339 got: %d", info.ID)
+	case info.DownloadTitle != "8MM 2 2005 1080p BluRay x264":
+		t.Fatalf("got wrong download title? got: %s", info.DownloadTitle)
+	case info.DownloadSize != 2158221056:
+		t.Fatalf("got wrong download size? wanted: 2158221056 got: %d", info.DownloadSize)
+	case len(info.CustomFormats) != 2 || info.CustomFormats[1] != "Bluray Tier 02":
+		t.Fatalf("got wrong custom formats? wanted: [x264 Bluray Tier 02] got: %v", info.CustomFormats)
+	case info.CustomFormatScore != 1500:
+		t.Fatalf("got wrong custom format score? wanted: 1500 got: %d", info.CustomFormatScore)
+	}
+}
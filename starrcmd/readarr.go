@@ -3,11 +3,14 @@ package starrcmd
 
 /*
 All 10 Readarr events accounted for; 1/30/2022.
+Added HealthRestored and AuthorAdded, and custom format variables on Grab.
 https://github.com/Readarr/Readarr/blob/develop/src/NzbDrone.Core/Notifications/CustomScript/CustomScript.cs
 */
 
 import (
 	"time"
+
+	"golift.io/starr"
 )
 
 // ReadarrApplicationUpdate is the ApplicationUpdate event.
@@ -25,24 +28,34 @@ type ReadarrHealthIssue struct {
 	Level     string `env:"readarr_health_issue_level"`   // Warning
 }
 
+// ReadarrHealthRestored is the HealthRestored event.
+type ReadarrHealthRestored struct {
+	Message   string `env:"readarr_health_restored_message"` // Lists unavailable due to failures: List name here
+	IssueType string `env:"readarr_health_restored_type"`    // ImportListStatusCheck
+	Wiki      string `env:"readarr_health_restored_wiki"`    // https://wiki.servarr.com/
+	Level     string `env:"readarr_health_restored_level"`   // Warning
+}
+
 // ReadarrGrab is the Grab event.
 type ReadarrGrab struct {
-	AuthorGRID     int64       `env:"readarr_author_grid"`                // 1077326
-	ReleaseGroup   string      `env:"readarr_release_releasegroup"`       // BitBook
-	AuthorName     string      `env:"readarr_author_name"`                // J.K. Rowling
-	ReleaseTitle   string      `env:"readarr_release_title"`              // J K Rowling - Harry Potter and the Order of the Phoenix 2012 Retail EPUB eBook-BitBook
-	GRIDs          string      `env:"readarr_release_grids"`              // 21175582 // not sure what this looks like with 2+
-	DownloadClient string      `env:"readarr_download_client"`            // qBittorrent
-	Size           int64       `env:"readarr_release_size"`               // 1279262
-	QualityVersion string      `env:"readarr_release_qualityversion"`     // 1
-	Titles         []string    `env:"readarr_release_booktitles,|"`       // Harry Potter and the Order of the Phoenix
-	IDs            []int64     `env:"readarr_release_bookids,|"`          // 649
-	ReleaseIndexer string      `env:"readarr_release_indexer"`            // InfoWars (Prowlarr)
-	DownloadID     string      `env:"readarr_download_id"`                // 3852BA2204A84185B2B43281E53BE93D56DE5C81
-	BookCount      int         `env:"readarr_release_bookcount"`          // 1
-	ReleaseDates   []time.Time `env:"readarr_release_bookreleasedates,,"` // 07/10/2003 07:00:00
-	Quality        string      `env:"readarr_release_quality"`            // EPUB
-	AuthorID       int64       `env:"readarr_author_id"`                  // 4
+	AuthorGRID        int64       `env:"readarr_author_grid"`                // 1077326
+	ReleaseGroup      string      `env:"readarr_release_releasegroup"`       // BitBook
+	AuthorName        string      `env:"readarr_author_name"`                // J.K. Rowling
+	ReleaseTitle      string      `env:"readarr_release_title"`              // J K Rowling - Harry Potter and the Order of the Phoenix 2012 Retail EPUB eBook-BitBook
+	GRIDs             string      `env:"readarr_release_grids"`              // 21175582 // not sure what this looks like with 2+
+	DownloadClient    string      `env:"readarr_download_client"`            // qBittorrent
+	Size              int64       `env:"readarr_release_size"`               // 1279262
+	QualityVersion    string      `env:"readarr_release_qualityversion"`     // 1
+	Titles            []string    `env:"readarr_release_booktitles,|"`       // Harry Potter and the Order of the Phoenix
+	IDs               []int64     `env:"readarr_release_bookids,|"`          // 649
+	ReleaseIndexer    string      `env:"readarr_release_indexer"`            // InfoWars (Prowlarr)
+	DownloadID        string      `env:"readarr_download_id"`                // 3852BA2204A84185B2B43281E53BE93D56DE5C81
+	BookCount         int         `env:"readarr_release_bookcount"`          // 1
+	ReleaseDates      []time.Time `env:"readarr_release_bookreleasedates,,"` // 07/10/2003 07:00:00
+	Quality           string      `env:"readarr_release_quality"`            // EPUB
+	AuthorID          int64       `env:"readarr_author_id"`                  // 4
+	CustomFormats     []string    `env:"readarr_release_customformat,|"`     // EPUB|Retail
+	CustomFormatScore int         `env:"readarr_release_customformatscore"`  // 100
 }
 
 // ReadarrBookDelete is the BookDelete event.
@@ -132,6 +145,16 @@ type ReadarrTrackRetag struct {
 	Scrubbed       bool      `env:"readarr_tags_scrubbed"`           // message.Scrubbed.ToString())
 }
 
+// ReadarrAuthorAdded is the AuthorAdded event.
+type ReadarrAuthorAdded struct {
+	InstanceName   string `env:"readarr_instancename"`       // Readarr
+	ApplicationURL string `env:"readarr_applicationurl"`     // https://readarr.example.com
+	AuthorID       int64  `env:"readarr_author_id"`          // 33
+	AuthorName     string `env:"readarr_author_name"`        // Alyssa Cole
+	Path           string `env:"readarr_author_path"`        // /books/Alyssa Cole
+	AuthorGrID     int64  `env:"readarr_author_goodreadsid"` // 7790155
+}
+
 // ReadarrTest has no members.
 type ReadarrTest struct{}
 
@@ -145,6 +168,11 @@ func (c *CmdEvent) GetReadarrHealthIssue() (output ReadarrHealthIssue, err error
 	return output, c.get(EventHealthIssue, &output)
 }
 
+// GetReadarrHealthRestored returns the HealthRestored event data.
+func (c *CmdEvent) GetReadarrHealthRestored() (output ReadarrHealthRestored, err error) {
+	return output, c.get(EventHealthRestored, &output)
+}
+
 // GetReadarrGrab returns the Grab event data.
 func (c *CmdEvent) GetReadarrGrab() (output ReadarrGrab, err error) {
 	return output, c.get(EventGrab, &output)
@@ -160,6 +188,11 @@ func (c *CmdEvent) GetReadarrAuthorDelete() (output ReadarrAuthorDelete, err err
 	return output, c.get(EventAuthorDelete, &output)
 }
 
+// GetReadarrAuthorAdded returns the AuthorAdded event data.
+func (c *CmdEvent) GetReadarrAuthorAdded() (output ReadarrAuthorAdded, err error) {
+	return output, c.get(EventAuthorAdded, &output)
+}
+
 // GetReadarrBookFileDelete returns the BookFileDelete event data.
 func (c *CmdEvent) GetReadarrBookFileDelete() (output ReadarrBookFileDelete, err error) {
 	return output, c.get(EventBookFileDelete, &output)
@@ -184,3 +217,147 @@ func (c *CmdEvent) GetReadarrTrackRetag() (output ReadarrTrackRetag, err error)
 func (c *CmdEvent) GetReadarrTest() (output ReadarrTest, err error) {
 	return output, c.get(EventTest, &output)
 }
+
+// OnReadarrApplicationUpdate registers a handler for the Readarr ApplicationUpdate event.
+func (r *Router) OnReadarrApplicationUpdate(handler func(ReadarrApplicationUpdate) error) {
+	r.Handle(starr.Readarr, EventApplicationUpdate, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrApplicationUpdate()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrHealthIssue registers a handler for the Readarr HealthIssue event.
+func (r *Router) OnReadarrHealthIssue(handler func(ReadarrHealthIssue) error) {
+	r.Handle(starr.Readarr, EventHealthIssue, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrHealthIssue()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrGrab registers a handler for the Readarr Grab event.
+func (r *Router) OnReadarrGrab(handler func(ReadarrGrab) error) {
+	r.Handle(starr.Readarr, EventGrab, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrGrab()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrBookDelete registers a handler for the Readarr BookDelete event.
+func (r *Router) OnReadarrBookDelete(handler func(ReadarrBookDelete) error) {
+	r.Handle(starr.Readarr, EventBookDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrBookDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrAuthorDelete registers a handler for the Readarr AuthorDelete event.
+func (r *Router) OnReadarrAuthorDelete(handler func(ReadarrAuthorDelete) error) {
+	r.Handle(starr.Readarr, EventAuthorDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrAuthorDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrBookFileDelete registers a handler for the Readarr BookFileDelete event.
+func (r *Router) OnReadarrBookFileDelete(handler func(ReadarrBookFileDelete) error) {
+	r.Handle(starr.Readarr, EventBookFileDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrBookFileDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrDownload registers a handler for the Readarr Download event.
+func (r *Router) OnReadarrDownload(handler func(ReadarrDownload) error) {
+	r.Handle(starr.Readarr, EventDownload, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrDownload()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrRename registers a handler for the Readarr Rename event.
+func (r *Router) OnReadarrRename(handler func(ReadarrRename) error) {
+	r.Handle(starr.Readarr, EventRename, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrRename()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrTrackRetag registers a handler for the Readarr TrackRetag event.
+func (r *Router) OnReadarrTrackRetag(handler func(ReadarrTrackRetag) error) {
+	r.Handle(starr.Readarr, EventTrackRetag, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrTrackRetag()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrTest registers a handler for the Readarr Test event.
+func (r *Router) OnReadarrTest(handler func(ReadarrTest) error) {
+	r.Handle(starr.Readarr, EventTest, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrTest()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrHealthRestored registers a handler for the Readarr HealthRestored event.
+func (r *Router) OnReadarrHealthRestored(handler func(ReadarrHealthRestored) error) {
+	r.Handle(starr.Readarr, EventHealthRestored, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrHealthRestored()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnReadarrAuthorAdded registers a handler for the Readarr AuthorAdded event.
+func (r *Router) OnReadarrAuthorAdded(handler func(ReadarrAuthorAdded) error) {
+	r.Handle(starr.Readarr, EventAuthorAdded, func(cmd *CmdEvent) error {
+		event, err := cmd.GetReadarrAuthorAdded()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
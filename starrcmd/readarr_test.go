@@ -263,3 +263,58 @@ func TestReadarrTrackRetag(t *testing.T) {
 		t.Fatalf("got an wrong author name? wanted: 'write here', got: %v", info.AuthorName)
 	}
 }
+
+func TestReadarrHealthRestored(t *testing.T) {
+	t.Setenv("readarr_eventtype", string(starrcmd.EventHealthRestored))
+	t.Setenv("readarr_health_restored_type", "DownloadClientCheck")
+	t.Setenv("readarr_health_restored_wiki", "https://wiki.servarr.com/readarr/system")
+	t.Setenv("readarr_health_restored_level", "Error")
+	t.Setenv("readarr_health_restored_message", "Unable to communicate with qBittorrent")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetReadarrHealthRestored(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.Message != os.Getenv("readarr_health_restored_message"):
+		t.Fatalf("got wrong Message? %s", info.Message)
+	case info.Wiki != "https://wiki.servarr.com/readarr/system":
+		t.Fatalf("got wrong wiki link? wanted: 'https://wiki.servarr.com/readarr/system' got: %s", info.Wiki)
+	case info.Level != "Error":
+		t.Fatalf("got wrong level? wanted: 'Error' got: %s", info.Level)
+	case info.IssueType != "DownloadClientCheck":
+		t.Fatalf("got wrong issue type? wanted: 'DownloadClientCheck' got: %s", info.IssueType)
+	}
+}
+
+func TestReadarrAuthorAdded(t *testing.T) {
+	t.Setenv("readarr_eventtype", string(starrcmd.EventAuthorAdded))
+	t.Setenv("readarr_instancename", "Readarr Audiobooks")
+	t.Setenv("readarr_author_id", "33")
+	t.Setenv("readarr_author_name", "Alyssa Cole")
+	t.Setenv("readarr_author_path", "/books/Alyssa Cole")
+	t.Setenv("readarr_author_goodreadsid", "7790155")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetReadarrAuthorAdded(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.InstanceName != "Readarr Audiobooks":
+		t.Fatalf("got wrong instance name? wanted: 'Readarr Audiobooks' got: %s", info.InstanceName)
+	case info.AuthorID != 33:
+		t.Fatalf("got wrong author ID? wanted: 33 got: %d", info.AuthorID)
+	case info.AuthorName != "Alyssa Cole":
+		t.Fatalf("got wrong author name? wanted: 'Alyssa Cole' got: %s", info.AuthorName)
+	case info.Path != "/books/Alyssa Cole":
+		t.Fatalf("got wrong path? wanted: '/books/Alyssa Cole' got: %s", info.Path)
+	case info.AuthorGrID != 7790155:
+		t.Fatalf("got wrong goodreads ID? wanted: 7790155 got: %d", info.AuthorGrID)
+	}
+}
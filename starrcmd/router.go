@@ -0,0 +1,118 @@
+package starrcmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golift.io/starr"
+)
+
+// Exit codes returned by Router.Run.
+const (
+	ExitSuccess = 0 // The event was handled, or ignored.
+	ExitFailure = 1 // The handler returned an error.
+	ExitNoEvent = 2 // No event was found in the environment.
+)
+
+// ExitError lets a handler choose the exit code Router.Run returns.
+// Any other error returned by a handler produces ExitFailure.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error satisfies the error interface.
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit code %d", e.Code)
+	}
+
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// HandlerFunc handles any event. The typed On* methods wrap one of these.
+type HandlerFunc func(cmd *CmdEvent) error
+
+// Router sends Custom Script events to the handlers registered for them.
+// Create one with NewRouter, register handlers with the On* methods, and call Run.
+// The zero value is not usable.
+type Router struct {
+	// Output is where Run writes handler errors. Defaults to os.Stderr.
+	// The Starr apps log anything written to stderr by a Custom Script.
+	Output   io.Writer
+	handlers map[starr.App]map[Event]HandlerFunc
+	fallback HandlerFunc
+}
+
+// NewRouter returns an empty event router.
+func NewRouter() *Router {
+	return &Router{
+		Output:   os.Stderr,
+		handlers: make(map[starr.App]map[Event]HandlerFunc),
+	}
+}
+
+// Handle registers a handler for an app's event. It replaces an existing handler for the same event.
+// Use this for events without a typed On* method, or to receive the raw command event.
+func (r *Router) Handle(app starr.App, event Event, handler HandlerFunc) {
+	if r.handlers[app] == nil {
+		r.handlers[app] = make(map[Event]HandlerFunc)
+	}
+
+	r.handlers[app][event] = handler
+}
+
+// Fallback registers a handler for events that do not have a handler.
+// Events without a handler are ignored if a fallback is not registered.
+func (r *Router) Fallback(handler HandlerFunc) {
+	r.fallback = handler
+}
+
+// Dispatch sends an event to its handler, or the fallback handler, and returns the handler's error.
+func (r *Router) Dispatch(cmd *CmdEvent) error {
+	handler := r.handlers[cmd.App][cmd.Type]
+	if handler == nil {
+		handler = r.fallback
+	}
+
+	if handler == nil {
+		return nil
+	}
+
+	if err := handler(cmd); err != nil {
+		return fmt.Errorf("%s %s: %w", cmd.App, cmd.Type, err)
+	}
+
+	return nil
+}
+
+// Run reads the event from the environment, and sends it to its handler.
+// It returns the exit code for your app. Errors are written to the Router's Output.
+// Use it like this: os.Exit(router.Run()).
+func (r *Router) Run() int {
+	cmd, err := New()
+	if err != nil {
+		fmt.Fprintln(r.Output, err)
+		return ExitNoEvent
+	}
+
+	if err = r.Dispatch(cmd); err == nil {
+		return ExitSuccess
+	}
+
+	fmt.Fprintln(r.Output, err)
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	return ExitFailure
+}
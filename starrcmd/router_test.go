@@ -0,0 +1,113 @@
+package starrcmd_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"golift.io/starr"
+	"golift.io/starr/starrcmd"
+)
+
+var errHandler = errors.New("handler failed")
+
+func TestRouterTyped(t *testing.T) {
+	t.Setenv("radarr_eventtype", string(starrcmd.EventDownload))
+	t.Setenv("radarr_movie_title", "Puss in Boots: The Last Wish")
+	t.Setenv("radarr_movie_year", "2022")
+
+	var title string
+
+	router := starrcmd.NewRouter()
+	router.OnRadarrDownload(func(download starrcmd.RadarrDownload) error {
+		title = download.Title
+		return nil
+	})
+	router.OnSonarrDownload(func(starrcmd.SonarrDownload) error {
+		t.Fatal("the sonarr handler must not run for a radarr event")
+		return nil
+	})
+	router.Fallback(func(cmd *starrcmd.CmdEvent) error {
+		t.Fatalf("the fallback handler must not run for a handled event: %v", cmd)
+		return nil
+	})
+
+	if code := router.Run(); code != starrcmd.ExitSuccess {
+		t.Fatalf("got wrong exit code? wanted: %d got: %d", starrcmd.ExitSuccess, code)
+	}
+
+	if title != os.Getenv("radarr_movie_title") {
+		t.Fatalf("got wrong title? %s", title)
+	}
+}
+
+func TestRouterErrors(t *testing.T) {
+	t.Setenv("sonarr_eventtype", string(starrcmd.EventGrab))
+
+	output := &bytes.Buffer{}
+	router := starrcmd.NewRouter()
+	router.Output = output
+	router.OnSonarrGrab(func(starrcmd.SonarrGrab) error { return errHandler })
+
+	if code := router.Run(); code != starrcmd.ExitFailure {
+		t.Fatalf("got wrong exit code? wanted: %d got: %d", starrcmd.ExitFailure, code)
+	}
+
+	if !strings.Contains(output.String(), "Sonarr Grab: handler failed") {
+		t.Fatalf("got wrong output? %s", output)
+	}
+
+	router.OnSonarrGrab(func(starrcmd.SonarrGrab) error { return &starrcmd.ExitError{Code: 9, Err: errHandler} })
+
+	if code := router.Run(); code != 9 { //nolint:gomnd
+		t.Fatalf("got wrong exit code? wanted: 9 got: %d", code)
+	}
+
+	// Parse errors go to the output too.
+	t.Setenv("sonarr_series_tvdbid", "not a number")
+
+	if code := router.Run(); code != starrcmd.ExitFailure {
+		t.Fatalf("got wrong exit code? wanted: %d got: %d", starrcmd.ExitFailure, code)
+	}
+}
+
+func TestRouterFallback(t *testing.T) {
+	t.Setenv("lidarr_eventtype", "SomethingNew")
+
+	router := starrcmd.NewRouter()
+
+	if code := router.Run(); code != starrcmd.ExitSuccess {
+		t.Fatalf("events without a handler must be ignored, got exit code: %d", code)
+	}
+
+	var event *starrcmd.CmdEvent
+
+	router.Fallback(func(cmd *starrcmd.CmdEvent) error {
+		event = cmd
+		return nil
+	})
+
+	if code := router.Run(); code != starrcmd.ExitSuccess {
+		t.Fatalf("got wrong exit code? wanted: %d got: %d", starrcmd.ExitSuccess, code)
+	}
+
+	if event == nil || event.App != starr.Lidarr || event.Type != "SomethingNew" {
+		t.Fatalf("got wrong fallback event? %v", event)
+	}
+}
+
+func TestRouterNoEvent(t *testing.T) {
+	output := &bytes.Buffer{}
+	router := starrcmd.NewRouter()
+	router.Output = output
+
+	if code := router.Run(); code != starrcmd.ExitNoEvent {
+		t.Fatalf("got wrong exit code? wanted: %d got: %d", starrcmd.ExitNoEvent, code)
+	}
+
+	if !strings.Contains(output.String(), starrcmd.ErrNoEventFound.Error()) {
+		t.Fatalf("got wrong output? %s", output)
+	}
+}
@@ -3,11 +3,15 @@ package starrcmd
 
 /*
 All events accounted for; 1/30/2022.
+Added HealthRestored, SeriesAdd and ManualInteractionRequired,
+and custom format and indexer flag variables on Grab and Download.
 https://github.com/Sonarr/Sonarr/blob/develop/src/NzbDrone.Core/Notifications/CustomScript/CustomScript.cs
 */
 
 import (
 	"time"
+
+	"golift.io/starr"
 )
 
 // SonarrApplicationUpdate is the ApplicationUpdate event.
@@ -25,6 +29,14 @@ type SonarrHealthIssue struct {
 	Level     string `env:"sonarr_health_issue_level"`   // Warning
 }
 
+// SonarrHealthRestored is the HealthRestored event.
+type SonarrHealthRestored struct {
+	Message   string `env:"sonarr_health_restored_message"` // Lists unavailable due to failures: List name here
+	IssueType string `env:"sonarr_health_restored_type"`    // ImportListStatusCheck
+	Wiki      string `env:"sonarr_health_restored_wiki"`    // https://wiki.servarr.com/
+	Level     string `env:"sonarr_health_restored_level"`   // Warning
+}
+
 // SonarrGrab is the Grab event.
 type SonarrGrab struct {
 	Quality            string      `env:"sonarr_release_quality"`                  // HDTV-720p
@@ -48,6 +60,10 @@ type SonarrGrab struct {
 	AbsEpisodeNumbers  []int       `env:"sonarr_release_absoluteepisodenumbers,,"` // 92
 	IMDbID             string      `env:"sonarr_series_imdbid"`                    // tt5555260
 	EpisodeAirDatesUTC []time.Time `env:"sonarr_release_episodeairdatesutc,,"`     // 1/26/2022 2:00:00 AM
+	CustomFormats      []string    `env:"sonarr_release_customformat,|"`           // x264|HDTV
+	CustomFormatScore  int         `env:"sonarr_release_customformatscore"`        // 120
+	IndexerFlags       string      `env:"sonarr_release_indexerflags"`             // G_Freeleech, G_Internal
+	DownloadClientType string      `env:"sonarr_download_client_type"`             // NZBGet
 }
 
 // SonarrDownload is the Download event.
@@ -80,6 +96,9 @@ type SonarrDownload struct {
 	IsUpgrade            bool        `env:"sonarr_isupgrade"`                        // False
 	DeletedRelativePaths []string    `env:"sonarr_deletedrelativepaths,|"`           // Not always present.
 	DeletedPaths         []string    `env:"sonarr_deletedpaths,|"`                   // Not always present.
+	CustomFormats        []string    `env:"sonarr_episodefile_customformat,|"`       // x264|WEB Tier 01
+	CustomFormatScore    int         `env:"sonarr_episodefile_customformatscore"`    // 1750
+	DownloadClientType   string      `env:"sonarr_download_client_type"`             // NZBGet
 }
 
 // SonarrRename is the Rename event.
@@ -136,6 +155,50 @@ type SonarrEpisodeFileDelete struct {
 	SceneName          string      `env:"sonarr_episodefile_scenename"`            // episodeFile.SceneName ?? string.Empty)
 }
 
+// SonarrSeriesAdd is the SeriesAdd event.
+type SonarrSeriesAdd struct {
+	InstanceName     string   `env:"sonarr_instancename"`            // Sonarr
+	ApplicationURL   string   `env:"sonarr_applicationurl"`          // https://sonarr.example.com
+	ID               int64    `env:"sonarr_series_id"`               // 47
+	Title            string   `env:"sonarr_series_title"`            // Severance
+	TitleSlug        string   `env:"sonarr_series_titleslug"`        // severance
+	Path             string   `env:"sonarr_series_path"`             // /tv/Severance
+	TVDbID           int64    `env:"sonarr_series_tvdbid"`           // 371980
+	TVMazeID         int64    `env:"sonarr_series_tvmazeid"`         // 44933
+	TMDbID           int64    `env:"sonarr_series_tmdbid"`           // 95396
+	IMDbID           string   `env:"sonarr_series_imdbid"`           // tt11280740
+	SeriesType       string   `env:"sonarr_series_type"`             // Standard
+	Year             int      `env:"sonarr_series_year"`             // 2022
+	OriginalLanguage string   `env:"sonarr_series_originallanguage"` // English
+	Genres           []string `env:"sonarr_series_genres,|"`         // Drama|Mystery|Science Fiction
+	Tags             []string `env:"sonarr_series_tags,|"`           // tv|4k
+}
+
+// SonarrManualInteractionRequired is the ManualInteractionRequired event.
+type SonarrManualInteractionRequired struct {
+	InstanceName       string      `env:"sonarr_instancename"`                 // Sonarr
+	ApplicationURL     string      `env:"sonarr_applicationurl"`               // https://sonarr.example.com
+	ID                 int64       `env:"sonarr_series_id"`                    // 47
+	Title              string      `env:"sonarr_series_title"`                 // Severance
+	Path               string      `env:"sonarr_series_path"`                  // /tv/Severance
+	TVDbID             int64       `env:"sonarr_series_tvdbid"`                // 371980
+	IMDbID             string      `env:"sonarr_series_imdbid"`                // tt11280740
+	SeriesType         string      `env:"sonarr_series_type"`                  // Standard
+	DownloadClient     string      `env:"sonarr_download_client"`              // qBittorrent
+	DownloadClientType string      `env:"sonarr_download_client_type"`         // qBittorrent
+	DownloadID         string      `env:"sonarr_download_id"`                  // 82A4BD7C4E1C0A5C5E2D5F8B9E3C26F1A1D0D7E3
+	DownloadSize       int64       `env:"sonarr_download_size"`                // 2158221056
+	DownloadTitle      string      `env:"sonarr_download_title"`               // Severance.S02E01.1080p.WEB.h264-ETHEL
+	EpisodeCount       int         `env:"sonarr_release_episodecount"`         // 1
+	SeasonNumber       int         `env:"sonarr_release_seasonnumber"`         // 2
+	EpisodeNumbers     []int       `env:"sonarr_release_episodenumbers,,"`     // 1
+	EpisodeAirDates    []string    `env:"sonarr_release_episodeairdates,,"`    // 2025-01-17
+	EpisodeAirDatesUTC []time.Time `env:"sonarr_release_episodeairdatesutc,,"` // 1/17/2025 2:00:00 AM
+	EpisodeTitles      []string    `env:"sonarr_release_episodetitles,|"`      // Hello, Ms. Cobel
+	CustomFormats      []string    `env:"sonarr_release_customformat,|"`       // x264|WEB Tier 01
+	CustomFormatScore  int         `env:"sonarr_release_customformatscore"`    // 1750
+}
+
 // SonarrTest has no members.
 type SonarrTest struct{}
 
@@ -149,6 +212,11 @@ func (c *CmdEvent) GetSonarrHealthIssue() (output SonarrHealthIssue, err error)
 	return output, c.get(EventHealthIssue, &output)
 }
 
+// GetSonarrHealthRestored returns the HealthRestored event data.
+func (c *CmdEvent) GetSonarrHealthRestored() (output SonarrHealthRestored, err error) {
+	return output, c.get(EventHealthRestored, &output)
+}
+
 // GetSonarrTest returns the ApplicationUpdate event data.
 func (c *CmdEvent) GetSonarrTest() (output SonarrTest, err error) {
 	return output, c.get(EventTest, &output)
@@ -174,7 +242,149 @@ func (c *CmdEvent) GetSonarrSeriesDelete() (output SonarrSeriesDelete, err error
 	return output, c.get(EventSeriesDelete, &output)
 }
 
+// GetSonarrSeriesAdd returns the SeriesAdd event data.
+func (c *CmdEvent) GetSonarrSeriesAdd() (output SonarrSeriesAdd, err error) {
+	return output, c.get(EventSeriesAdd, &output)
+}
+
 // GetSonarrEpisodeFileDelete returns the EpisodeFileDelete event data.
 func (c *CmdEvent) GetSonarrEpisodeFileDelete() (output SonarrEpisodeFileDelete, err error) {
 	return output, c.get(EventEpisodeFileDelete, &output)
 }
+
+// GetSonarrManualInteractionRequired returns the ManualInteractionRequired event data.
+func (c *CmdEvent) GetSonarrManualInteractionRequired() (output SonarrManualInteractionRequired, err error) {
+	return output, c.get(EventManualInteractionRequired, &output)
+}
+
+// OnSonarrApplicationUpdate registers a handler for the Sonarr ApplicationUpdate event.
+func (r *Router) OnSonarrApplicationUpdate(handler func(SonarrApplicationUpdate) error) {
+	r.Handle(starr.Sonarr, EventApplicationUpdate, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrApplicationUpdate()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrHealthIssue registers a handler for the Sonarr HealthIssue event.
+func (r *Router) OnSonarrHealthIssue(handler func(SonarrHealthIssue) error) {
+	r.Handle(starr.Sonarr, EventHealthIssue, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrHealthIssue()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrTest registers a handler for the Sonarr Test event.
+func (r *Router) OnSonarrTest(handler func(SonarrTest) error) {
+	r.Handle(starr.Sonarr, EventTest, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrTest()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrGrab registers a handler for the Sonarr Grab event.
+func (r *Router) OnSonarrGrab(handler func(SonarrGrab) error) {
+	r.Handle(starr.Sonarr, EventGrab, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrGrab()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrDownload registers a handler for the Sonarr Download event.
+func (r *Router) OnSonarrDownload(handler func(SonarrDownload) error) {
+	r.Handle(starr.Sonarr, EventDownload, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrDownload()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrRename registers a handler for the Sonarr Rename event.
+func (r *Router) OnSonarrRename(handler func(SonarrRename) error) {
+	r.Handle(starr.Sonarr, EventRename, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrRename()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrSeriesDelete registers a handler for the Sonarr SeriesDelete event.
+func (r *Router) OnSonarrSeriesDelete(handler func(SonarrSeriesDelete) error) {
+	r.Handle(starr.Sonarr, EventSeriesDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrSeriesDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrEpisodeFileDelete registers a handler for the Sonarr EpisodeFileDelete event.
+func (r *Router) OnSonarrEpisodeFileDelete(handler func(SonarrEpisodeFileDelete) error) {
+	r.Handle(starr.Sonarr, EventEpisodeFileDelete, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrEpisodeFileDelete()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrHealthRestored registers a handler for the Sonarr HealthRestored event.
+func (r *Router) OnSonarrHealthRestored(handler func(SonarrHealthRestored) error) {
+	r.Handle(starr.Sonarr, EventHealthRestored, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrHealthRestored()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrSeriesAdd registers a handler for the Sonarr SeriesAdd event.
+func (r *Router) OnSonarrSeriesAdd(handler func(SonarrSeriesAdd) error) {
+	r.Handle(starr.Sonarr, EventSeriesAdd, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrSeriesAdd()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
+
+// OnSonarrManualInteractionRequired registers a handler for the Sonarr ManualInteractionRequired event.
+func (r *Router) OnSonarrManualInteractionRequired(handler func(SonarrManualInteractionRequired) error) {
+	r.Handle(starr.Sonarr, EventManualInteractionRequired, func(cmd *CmdEvent) error {
+		event, err := cmd.GetSonarrManualInteractionRequired()
+		if err != nil {
+			return err
+		}
+
+		return handler(event)
+	})
+}
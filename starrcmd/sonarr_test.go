@@ -240,3 +240,133 @@ func TestSonarrEpisodeFileDelete(t *testing.T) {
 		t.Fatalf("got wrong ID? expected: 12345, got: %v", info.ID)
 	}
 }
+
+func TestSonarrHealthRestored(t *testing.T) {
+	t.Setenv("sonarr_eventtype", string(starrcmd.EventHealthRestored))
+	t.Setenv("sonarr_health_restored_type", "IndexerRssCheck")
+	t.Setenv("sonarr_health_restored_wiki", "https://wiki.servarr.com/sonarr/system")
+	t.Setenv("sonarr_health_restored_level", "Warning")
+	t.Setenv("sonarr_health_restored_message", "All rss-capable indexers are temporarily unavailable due to recent indexer errors")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetSonarrHealthRestored(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.Message != os.Getenv("sonarr_health_restored_message"):
+		t.Fatalf("got wrong Message? %s", info.Message)
+	case info.Wiki != "https://wiki.servarr.com/sonarr/system":
+		t.Fatalf("got wrong wiki link? wanted: 'https://wiki.servarr.com/sonarr/system' got: %s", info.Wiki)
+	case info.Level != "Warning":
+		t.Fatalf("got wrong level? wanted: 'Warning' got: %s", info.Level)
+	case info.IssueType != "IndexerRssCheck":
+		t.Fatalf("got wrong issue type? wanted: 'IndexerRssCheck' got: %s", info.IssueType)
+	}
+}
+
+func TestSonarrSeriesAdd(t *testing.T) {
+	t.Setenv("sonarr_eventtype", string(starrcmd.EventSeriesAdd))
+	t.Setenv("sonarr_instancename", "Sonarr 4K")
+	t.Setenv("sonarr_applicationurl", "https://sonarr.example.com")
+	t.Setenv("sonarr_series_id", "47")
+	t.Setenv("sonarr_series_title", "Severance")
+	t.Setenv("sonarr_series_titleslug", "severance")
+	t.Setenv("sonarr_series_path", "/tv/Severance")
+	t.Setenv("sonarr_series_tvdbid", "371980")
+	t.Setenv("sonarr_series_tvmazeid", "44933")
+	t.Setenv("sonarr_series_tmdbid", "95396")
+	t.Setenv("sonarr_series_imdbid", "tt11280740")
+	t.Setenv("sonarr_series_type", "Standard")
+	t.Setenv("sonarr_series_year", "2022")
+	t.Setenv("sonarr_series_originallanguage", "English")
+	t.Setenv("sonarr_series_genres", "Drama|Mystery|Science Fiction")
+	t.Setenv("sonarr_series_tags", "")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetSonarrSeriesAdd(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.InstanceName != "Sonarr 4K":
+		t.Fatalf("got wrong instance name? wanted: 'Sonarr 4K' got: %s", info.InstanceName)
+	case info.Title != "Severance":
+		t.Fatalf("got wrong title? wanted: 'Severance' got: %s", info.Title)
+	case info.ID != 47:
+		t.Fatalf("got wrong ID? wanted: 47 got: %d", info.ID)
+	case info.TMDbID != 95396:
+		t.Fatalf("got wrong TMDb ID? wanted: 95396 got: %d", info.TMDbID)
+	case info.Year != 2022:
+		t.Fatalf("got wrong year? wanted: 2022 got: %d", info.Year)
+	case len(info.Genres) != 3 || info.Genres[2] != "Science Fiction":
+		t.Fatalf("got wrong genres? wanted: 3 got: %v", info.Genres)
+	case len(info.Tags) != 0:
+		t.Fatalf("got wrong tags? wanted: 0 got: %v", info.Tags)
+	}
+}
+
+func TestSonarrManualInteractionRequired(t *testing.T) {
+	t.Setenv("sonarr_eventtype", string(starrcmd.EventManualInteractionRequired))
+	t.Setenv("sonarr_series_id", "47")
+	t.Setenv("sonarr_series_title", "Severance")
+	t.Setenv("sonarr_download_client", "qBit")
+	t.Setenv("sonarr_download_client_type", "qBittorrent")
+	t.Setenv("sonarr_download_id", "82A4BD7C4E1C0A5C5E2D5F8B9E3C26F1A1D0D7E3")
+	t.Setenv("sonarr_download_size", "2158221056")
+	t.Setenv("sonarr_download_title", "Severance.S02E01.1080p.WEB.h264-ETHEL")
+	t.Setenv("sonarr_release_episodenumbers", "1,2")
+	t.Setenv("sonarr_release_episodeairdates", "2025-01-17,2025-01-24")
+	t.Setenv("sonarr_release_episodeairdatesutc", "1/17/2025 2:00:00 AM,1/24/2025 2:00:00 AM")
+	t.Setenv("sonarr_release_customformat", "x264|WEB Tier 01")
+	t.Setenv("sonarr_release_customformatscore", "1750")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetSonarrManualInteractionRequired(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case info.DownloadClientType != "qBittorrent":
+		t.Fatalf("got wrong download client type? wanted: 'qBittorrent' got: %s", info.DownloadClientType)
+	case info.DownloadSize != 2158221056:
+		t.Fatalf("got wrong download size? wanted: 2158221056 got: %d", info.DownloadSize)
+	case len(info.EpisodeNumbers) != 2 || info.EpisodeNumbers[1] != 2:
+		t.Fatalf("got wrong episode numbers? wanted: [1 2] got: %v", info.EpisodeNumbers)
+	case len(info.EpisodeAirDatesUTC) != 2 || info.EpisodeAirDatesUTC[1].Day() != 24:
+		t.Fatalf("got wrong episode air dates? got: %v", info.EpisodeAirDatesUTC)
+	case len(info.CustomFormats) != 2 || info.CustomFormats[1] != "WEB Tier 01":
+		t.Fatalf("got wrong custom formats? wanted: [x264 WEB Tier 01] got: %v", info.CustomFormats)
+	case info.CustomFormatScore != 1750:
+		t.Fatalf("got wrong custom format score? wanted: 1750 got: %d", info.CustomFormatScore)
+	}
+}
+
+func TestSonarrGrabCustomFormats(t *testing.T) {
+	t.Setenv("sonarr_eventtype", string(starrcmd.EventGrab))
+	t.Setenv("sonarr_release_customformat", "x264|HDTV")
+	t.Setenv("sonarr_release_customformatscore", "-10")
+	t.Setenv("sonarr_release_indexerflags", "G_Freeleech, G_Internal")
+
+	cmd, err := starrcmd.New()
+	if err != nil {
+		t.Fatalf("got an unexpected error: %s", err)
+	}
+
+	switch info, err := cmd.GetSonarrGrab(); {
+	case err != nil:
+		t.Fatalf("got an unexpected error: %s", err)
+	case len(info.CustomFormats) != 2 || info.CustomFormats[0] != "x264":
+		t.Fatalf("got wrong custom formats? wanted: [x264 HDTV] got: %v", info.CustomFormats)
+	case info.CustomFormatScore != -10:
+		t.Fatalf("got wrong custom format score? wanted: -10 got: %d", info.CustomFormatScore)
+	case info.IndexerFlags != "G_Freeleech, G_Internal":
+		t.Fatalf("got wrong indexer flags? wanted: 'G_Freeleech, G_Internal' got: %s", info.IndexerFlags)
+	}
+}
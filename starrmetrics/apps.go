@@ -0,0 +1,219 @@
+package starrmetrics
+
+import (
+	"context"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/sonarr"
+)
+
+// AddSonarr adds a Sonarr instance to the collector. The name is used as the instance label.
+// The client may be a *sonarr.Sonarr, or anything else that satisfies sonarr.API.
+func (c *Collector) AddSonarr(name string, client sonarr.API) {
+	c.add(starr.Sonarr.String(), name, func(ctx context.Context, s *scrape) error {
+		status, err := client.GetSystemStatusContext(ctx)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.add("info", 1, "version", status.Version)
+
+		if queue, err := client.GetQueuePageContext(ctx, &starr.PageReq{PageSize: 1}); s.ok(err) {
+			s.add("queue_records", float64(queue.TotalRecords))
+		}
+
+		if history, err := client.GetHistoryPageContext(ctx, s.history()); s.ok(err) {
+			counts := make(map[string]int)
+			for _, record := range history.Records {
+				counts[record.EventType]++
+			}
+
+			s.counts("history_events", "event_type", counts)
+		}
+
+		start, end := s.calendar()
+		if episodes, err := client.GetCalendarContext(ctx, sonarr.Calendar{Start: start, End: end}); s.ok(err) {
+			missing := 0
+
+			for _, episode := range episodes {
+				if episode.Monitored && !episode.HasFile {
+					missing++
+				}
+			}
+
+			s.add("missing_recent", float64(missing))
+		}
+
+		s.diskSpace(client.GetDiskSpaceContext(ctx))
+		s.health(client.GetHealthContext(ctx))
+
+		return nil
+	})
+}
+
+// AddRadarr adds a Radarr instance to the collector. The name is used as the instance label.
+// The client may be a *radarr.Radarr, or anything else that satisfies radarr.API.
+func (c *Collector) AddRadarr(name string, client radarr.API) {
+	c.add(starr.Radarr.String(), name, func(ctx context.Context, s *scrape) error {
+		status, err := client.GetSystemStatusContext(ctx)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.add("info", 1, "version", status.Version)
+
+		if queue, err := client.GetQueuePageContext(ctx, &starr.PageReq{PageSize: 1}); s.ok(err) {
+			s.add("queue_records", float64(queue.TotalRecords))
+		}
+
+		if history, err := client.GetHistoryPageContext(ctx, s.history()); s.ok(err) {
+			counts := make(map[string]int)
+			for _, record := range history.Records {
+				counts[record.EventType]++
+			}
+
+			s.counts("history_events", "event_type", counts)
+		}
+
+		start, end := s.calendar()
+		if movies, err := client.GetCalendarContext(ctx, radarr.Calendar{Start: start, End: end}); s.ok(err) {
+			missing := 0
+
+			for _, movie := range movies {
+				if movie.Monitored && !movie.HasFile {
+					missing++
+				}
+			}
+
+			s.add("missing_recent", float64(missing))
+		}
+
+		s.diskSpace(client.GetDiskSpaceContext(ctx))
+		s.health(client.GetHealthContext(ctx))
+
+		return nil
+	})
+}
+
+// AddLidarr adds a Lidarr instance to the collector. The name is used as the instance label.
+// The client may be a *lidarr.Lidarr, or anything else that satisfies lidarr.API.
+func (c *Collector) AddLidarr(name string, client lidarr.API) {
+	c.add(starr.Lidarr.String(), name, func(ctx context.Context, s *scrape) error {
+		status, err := client.GetSystemStatusContext(ctx)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.add("info", 1, "version", status.Version)
+
+		if queue, err := client.GetQueuePageContext(ctx, &starr.PageReq{PageSize: 1}); s.ok(err) {
+			s.add("queue_records", float64(queue.TotalRecords))
+		}
+
+		if history, err := client.GetHistoryPageContext(ctx, s.history()); s.ok(err) {
+			counts := make(map[string]int)
+			for _, record := range history.Records {
+				counts[record.EventType]++
+			}
+
+			s.counts("history_events", "event_type", counts)
+		}
+
+		start, end := s.calendar()
+		if albums, err := client.GetCalendarContext(ctx, lidarr.Calendar{Start: start, End: end}); s.ok(err) {
+			missing := 0
+
+			for _, album := range albums {
+				if album.Monitored && album.Statistics != nil &&
+					album.Statistics.TrackFileCount < album.Statistics.TrackCount {
+					missing++
+				}
+			}
+
+			s.add("missing_recent", float64(missing))
+		}
+
+		s.diskSpace(client.GetDiskSpaceContext(ctx))
+		s.health(client.GetHealthContext(ctx))
+
+		return nil
+	})
+}
+
+// AddReadarr adds a Readarr instance to the collector. The name is used as the instance label.
+// The client may be a *readarr.Readarr, or anything else that satisfies readarr.API.
+func (c *Collector) AddReadarr(name string, client readarr.API) {
+	c.add(starr.Readarr.String(), name, func(ctx context.Context, s *scrape) error {
+		status, err := client.GetSystemStatusContext(ctx)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.add("info", 1, "version", status.Version)
+
+		if queue, err := client.GetQueuePageContext(ctx, &starr.PageReq{PageSize: 1}); s.ok(err) {
+			s.add("queue_records", float64(queue.TotalRecords))
+		}
+
+		if history, err := client.GetHistoryPageContext(ctx, s.history()); s.ok(err) {
+			counts := make(map[string]int)
+			for _, record := range history.Records {
+				counts[record.EventType]++
+			}
+
+			s.counts("history_events", "event_type", counts)
+		}
+
+		start, end := s.calendar()
+		if books, err := client.GetCalendarContext(ctx, readarr.Calendar{Start: start, End: end}); s.ok(err) {
+			missing := 0
+
+			for _, book := range books {
+				if book.Monitored && (book.Statistics == nil || book.Statistics.BookFileCount == 0) {
+					missing++
+				}
+			}
+
+			s.add("missing_recent", float64(missing))
+		}
+
+		s.diskSpace(client.GetDiskSpaceContext(ctx))
+		s.health(client.GetHealthContext(ctx))
+
+		return nil
+	})
+}
+
+// AddProwlarr adds a Prowlarr instance to the collector. The name is used as the instance label.
+// The client may be a *prowlarr.Prowlarr, or anything else that satisfies prowlarr.API.
+// Prowlarr has no queue, history, calendar or disk metrics, but it has indexer statistics.
+func (c *Collector) AddProwlarr(name string, client prowlarr.API) {
+	c.add(starr.Prowlarr.String(), name, func(ctx context.Context, s *scrape) error {
+		status, err := client.GetSystemStatusContext(ctx)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.add("info", 1, "version", status.Version)
+
+		if stats, err := client.GetIndexerStatsContext(ctx, time.Time{}, time.Time{}); s.ok(err) {
+			for _, indexer := range stats.Indexers {
+				s.add("indexer_queries", float64(indexer.NumberOfQueries), "indexer", indexer.IndexerName)
+				s.add("indexer_grabs", float64(indexer.NumberOfGrabs), "indexer", indexer.IndexerName)
+				s.add("indexer_failed_queries", float64(indexer.NumberOfFailedQueries), "indexer", indexer.IndexerName)
+				s.add("indexer_failed_grabs", float64(indexer.NumberOfFailedGrabs), "indexer", indexer.IndexerName)
+				s.add("indexer_response_seconds",
+					(time.Duration(indexer.AverageResponseTime) * time.Millisecond).Seconds(), "indexer", indexer.IndexerName)
+			}
+		}
+
+		s.health(client.GetHealthContext(ctx))
+
+		return nil
+	})
+}
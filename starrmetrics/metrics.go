@@ -0,0 +1,262 @@
+// Package starrmetrics provides a metrics collector for the Starr apps.
+// It scrapes every configured app instance when its metrics are requested,
+// and writes them in the Prometheus text exposition format.
+// This package does not import the Prometheus client library.
+// Serve the collector on an HTTP path and point a Prometheus job at it:
+//
+//	collector := starrmetrics.New(nil)
+//	collector.AddSonarr("sonarr4k", sonarr.New(starr.New(apiKey, url, 0)))
+//	http.Handle("/metrics", collector)
+//
+// Every metric has an app and an instance label. The instance label is the name given to the Add method.
+package starrmetrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config defaults. Used when a Config value is not provided.
+const (
+	DefaultNamespace      = "starr"
+	DefaultTimeout        = 10 * time.Second
+	DefaultHistoryRecords = 250
+	DefaultMissingDays    = 30
+)
+
+// ContentType is the Prometheus text exposition format content type.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Config is the input data for a Collector. All values are optional.
+type Config struct {
+	// Namespace is the prefix for every metric name. Default: starr
+	Namespace string
+	// Timeout is the maximum duration to scrape a single instance. Default: 10 seconds.
+	Timeout time.Duration
+	// HistoryRecords is the number of recent history records counted by event type. Default: 250
+	HistoryRecords int
+	// MissingDays is how many days back the calendar is checked for missing items. Default: 30
+	MissingDays int
+}
+
+// Metric is a single value with its labels.
+type Metric struct {
+	Name   string
+	Help   string
+	Type   MetricType
+	Labels []Label
+	Value  float64
+}
+
+// MetricType is the Prometheus type of a metric.
+type MetricType string
+
+// These are the metric types the collector writes.
+const (
+	// Gauge values may go up and down. This is used when a metric has no type.
+	Gauge MetricType = "gauge"
+	// Counter values only go up, ie. the total queries sent to an indexer.
+	Counter MetricType = "counter"
+)
+
+// Label is a metric label name and value.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Collector scrapes Starr app instances. Create one with New.
+// Add instances with the Add methods, then call Collect or serve it over HTTP.
+type Collector struct {
+	config    Config
+	mu        sync.RWMutex
+	instances []*instance
+}
+
+// instance is a single app to scrape.
+type instance struct {
+	app    string
+	name   string
+	scrape func(ctx context.Context, s *scrape) error
+}
+
+// New returns a metrics collector. The config may be nil to use the defaults.
+func New(config *Config) *Collector {
+	if config == nil {
+		config = &Config{}
+	}
+
+	collector := &Collector{config: *config}
+
+	if collector.config.Namespace == "" {
+		collector.config.Namespace = DefaultNamespace
+	}
+
+	if collector.config.Timeout <= 0 {
+		collector.config.Timeout = DefaultTimeout
+	}
+
+	if collector.config.HistoryRecords <= 0 {
+		collector.config.HistoryRecords = DefaultHistoryRecords
+	}
+
+	if collector.config.MissingDays <= 0 {
+		collector.config.MissingDays = DefaultMissingDays
+	}
+
+	return collector
+}
+
+func (c *Collector) add(app, name string, scrape func(ctx context.Context, s *scrape) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.instances = append(c.instances, &instance{app: app, name: name, scrape: scrape})
+}
+
+// Collect scrapes every instance concurrently, and returns all of their metrics sorted by name.
+// Instances that fail to respond have an up metric of 0.
+func (c *Collector) Collect(ctx context.Context) []*Metric {
+	c.mu.RLock()
+	instances := append([]*instance{}, c.instances...)
+	c.mu.RUnlock()
+
+	var (
+		wg      sync.WaitGroup
+		scrapes = make([]*scrape, len(instances))
+	)
+
+	for idx, inst := range instances {
+		wg.Add(1)
+
+		go func(idx int, inst *instance) {
+			defer wg.Done()
+
+			scrapes[idx] = c.scrape(ctx, inst)
+		}(idx, inst)
+	}
+
+	wg.Wait()
+
+	metrics := []*Metric{}
+	for _, scrape := range scrapes {
+		metrics = append(metrics, scrape.metrics...)
+	}
+
+	sort.SliceStable(metrics, func(i, j int) bool {
+		if metrics[i].Name != metrics[j].Name {
+			return metrics[i].Name < metrics[j].Name
+		}
+
+		return labelString(metrics[i].Labels) < labelString(metrics[j].Labels)
+	})
+
+	return metrics
+}
+
+func (c *Collector) scrape(ctx context.Context, inst *instance) *scrape {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	scrape := &scrape{Collector: c, app: inst.app, instance: inst.name}
+
+	up := 1.0
+	if err := inst.scrape(ctx, scrape); err != nil {
+		up = 0
+		scrape.errors++
+	}
+
+	scrape.add("up", up)
+	scrape.add("scrape_errors", float64(scrape.errors))
+	scrape.add("scrape_duration_seconds", time.Since(start).Seconds())
+
+	return scrape
+}
+
+// Write scrapes every instance and writes the metrics in the Prometheus text exposition format.
+func (c *Collector) Write(ctx context.Context, writer io.Writer) error {
+	return WriteText(writer, c.Collect(ctx))
+}
+
+// ServeHTTP satisfies the http.Handler interface, and serves the metrics.
+func (c *Collector) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", ContentType)
+	_ = c.Write(req.Context(), writer)
+}
+
+// WriteText writes metrics in the Prometheus text exposition format.
+// Metrics with the same name must be next to each other, like Collect returns them.
+func WriteText(writer io.Writer, metrics []*Metric) error {
+	var (
+		buf  strings.Builder
+		last string
+	)
+
+	for _, metric := range metrics {
+		if metric.Name != last {
+			last = metric.Name
+			kind := metric.Type
+
+			if kind == "" {
+				kind = Gauge
+			}
+
+			fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s %s\n", metric.Name, escape(metric.Help, false), metric.Name, kind)
+		}
+
+		fmt.Fprintf(&buf, "%s%s %s\n", metric.Name, labelString(metric.Labels), formatValue(metric.Value))
+	}
+
+	if _, err := io.WriteString(writer, buf.String()); err != nil {
+		return fmt.Errorf("writing metrics: %w", err)
+	}
+
+	return nil
+}
+
+func labelString(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, len(labels))
+	for idx, label := range labels {
+		pairs[idx] = label.Name + `="` + escape(label.Value, true) + `"`
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// escape escapes a help string or a label value, per the text exposition format.
+func escape(value string, quotes bool) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+
+	if quotes {
+		value = strings.ReplaceAll(value, `"`, `\"`)
+	}
+
+	return value
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,149 @@
+package starrmetrics_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrmetrics"
+	"golift.io/starr/starrtest/mocks"
+)
+
+var errTest = errors.New("test error")
+
+func mockSonarr() *mocks.Sonarr {
+	return &mocks.Sonarr{
+		GetSystemStatusContextFunc: func(context.Context) (*sonarr.SystemStatus, error) {
+			return &sonarr.SystemStatus{Version: "4.0.1"}, nil
+		},
+		GetQueuePageContextFunc: func(_ context.Context, params *starr.PageReq) (*sonarr.Queue, error) {
+			return &sonarr.Queue{TotalRecords: 7}, nil
+		},
+		GetHistoryPageContextFunc: func(_ context.Context, params *starr.PageReq) (*sonarr.History, error) {
+			return &sonarr.History{Records: []*sonarr.HistoryRecord{
+				{EventType: "grabbed"}, {EventType: "grabbed"}, {EventType: "downloadFolderImported"},
+			}}, nil
+		},
+		GetCalendarContextFunc: func(_ context.Context, filter sonarr.Calendar) ([]*sonarr.Episode, error) {
+			return []*sonarr.Episode{{Monitored: true}, {Monitored: true, HasFile: true}, {}}, nil
+		},
+		GetDiskSpaceContextFunc: func(context.Context) ([]*starr.DiskSpace, error) {
+			return []*starr.DiskSpace{{Path: `C:\tv "shows"`, FreeSpace: 100, TotalSpace: 1e12}}, nil
+		},
+		GetHealthContextFunc: func(context.Context) ([]*starr.Health, error) {
+			return nil, errTest
+		},
+	}
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	collector := starrmetrics.New(nil)
+	collector.AddSonarr("tv", mockSonarr())
+	collector.AddProwlarr("indexers", &mocks.Prowlarr{
+		GetSystemStatusContextFunc: func(context.Context) (*prowlarr.SystemStatus, error) {
+			return nil, errTest
+		},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, collector.Write(context.Background(), &buf))
+
+	expected := []string{
+		"# HELP starr_queue_records Number of records in the activity queue.\n# TYPE starr_queue_records gauge\n",
+		`starr_queue_records{app="Sonarr",instance="tv"} 7` + "\n",
+		`starr_history_events{app="Sonarr",instance="tv",event_type="grabbed"} 2` + "\n",
+		`starr_history_events{app="Sonarr",instance="tv",event_type="downloadFolderImported"} 1` + "\n",
+		`starr_missing_recent{app="Sonarr",instance="tv"} 1` + "\n",
+		`starr_info{app="Sonarr",instance="tv",version="4.0.1"} 1` + "\n",
+		`starr_disk_total_bytes{app="Sonarr",instance="tv",path="C:\\tv \"shows\""} 1e+12` + "\n",
+		`starr_up{app="Sonarr",instance="tv"} 1` + "\n",
+		`starr_scrape_errors{app="Sonarr",instance="tv"} 1` + "\n", // GetHealth failed.
+		`starr_up{app="Prowlarr",instance="indexers"} 0` + "\n",
+	}
+
+	for _, line := range expected {
+		assert.Contains(t, buf.String(), line)
+	}
+
+	assert.NotContains(t, buf.String(), "starr_health_issues")
+	assert.NotContains(t, buf.String(), `starr_info{app="Prowlarr"`)
+}
+
+func TestCollectorCounters(t *testing.T) {
+	t.Parallel()
+
+	collector := starrmetrics.New(nil)
+	collector.AddProwlarr("indexers", &mocks.Prowlarr{
+		GetSystemStatusContextFunc: func(context.Context) (*prowlarr.SystemStatus, error) {
+			return &prowlarr.SystemStatus{Version: "1.10.0"}, nil
+		},
+		GetIndexerStatsContextFunc: func(_ context.Context, _, _ time.Time) (*prowlarr.IndexerStats, error) {
+			return &prowlarr.IndexerStats{Indexers: []*prowlarr.IndexerStatistics{
+				{IndexerName: "Hydra", NumberOfQueries: 12, AverageResponseTime: 250},
+			}}, nil
+		},
+		GetHealthContextFunc: func(context.Context) ([]*starr.Health, error) {
+			return nil, nil
+		},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, collector.Write(context.Background(), &buf))
+
+	expected := []string{
+		"# TYPE starr_indexer_queries counter\n",
+		`starr_indexer_queries{app="Prowlarr",instance="indexers",indexer="Hydra"} 12` + "\n",
+		"# TYPE starr_indexer_failed_grabs counter\n",
+		"# TYPE starr_indexer_response_seconds gauge\n",
+		`starr_indexer_response_seconds{app="Prowlarr",instance="indexers",indexer="Hydra"} 0.25` + "\n",
+	}
+
+	for _, line := range expected {
+		assert.Contains(t, buf.String(), line)
+	}
+}
+
+func TestCollectorServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	collector := starrmetrics.New(&starrmetrics.Config{Namespace: "test", Timeout: time.Second})
+	collector.AddSonarr("tv", mockSonarr())
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, starrmetrics.ContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `test_up{app="Sonarr",instance="tv"} 1`)
+}
+
+func TestCollectorTimeout(t *testing.T) {
+	t.Parallel()
+
+	collector := starrmetrics.New(&starrmetrics.Config{Timeout: time.Millisecond})
+	collector.AddSonarr("slow", &mocks.Sonarr{
+		GetSystemStatusContextFunc: func(ctx context.Context) (*sonarr.SystemStatus, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+
+	for _, metric := range collector.Collect(context.Background()) {
+		if metric.Name == "starr_up" {
+			assert.Zero(t, metric.Value, "the instance must be down after a timeout")
+			return
+		}
+	}
+
+	t.Fatal("starr_up metric is missing")
+}
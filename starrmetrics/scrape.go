@@ -0,0 +1,127 @@
+package starrmetrics
+
+import (
+	"sort"
+	"time"
+
+	"golift.io/starr"
+)
+
+// help contains the help text for every metric, by name without the namespace.
+var help = map[string]string{ //nolint:gochecknoglobals
+	"up":                       "Whether the last scrape of the instance's system status was successful.",
+	"scrape_errors":            "Number of requests that failed during the last scrape of the instance.",
+	"scrape_duration_seconds":  "Duration of the last scrape of the instance.",
+	"info":                     "Instance information from the system status. Always 1.",
+	"queue_records":            "Number of records in the activity queue.",
+	"missing_recent":           "Number of monitored calendar items released in the recent past that have no file.",
+	"history_events":           "Number of recent history records, by event type.",
+	"disk_free_bytes":          "Free space on a disk.",
+	"disk_total_bytes":         "Total space on a disk.",
+	"health_issues":            "Number of health check issues, by source and type.",
+	"indexer_queries":          "Number of queries sent to an indexer.",
+	"indexer_grabs":            "Number of releases grabbed from an indexer.",
+	"indexer_failed_queries":   "Number of failed queries sent to an indexer.",
+	"indexer_failed_grabs":     "Number of failed grabs from an indexer.",
+	"indexer_response_seconds": "Average response time of an indexer.",
+}
+
+// counters are the metrics that only go up, by name without the namespace. The rest are gauges.
+var counters = map[string]bool{ //nolint:gochecknoglobals
+	"indexer_queries":        true,
+	"indexer_grabs":          true,
+	"indexer_failed_queries": true,
+	"indexer_failed_grabs":   true,
+}
+
+// scrape holds the metrics from a single instance scrape.
+type scrape struct {
+	*Collector
+	app      string
+	instance string
+	errors   int
+	metrics  []*Metric
+}
+
+// add adds a metric. Labels are name and value pairs.
+func (s *scrape) add(name string, value float64, labels ...string) {
+	metric := &Metric{
+		Name:   s.config.Namespace + "_" + name,
+		Help:   help[name],
+		Type:   Gauge,
+		Labels: []Label{{Name: "app", Value: s.app}, {Name: "instance", Value: s.instance}},
+		Value:  value,
+	}
+
+	if counters[name] {
+		metric.Type = Counter
+	}
+
+	for idx := 0; idx+1 < len(labels); idx += 2 {
+		metric.Labels = append(metric.Labels, Label{Name: labels[idx], Value: labels[idx+1]})
+	}
+
+	s.metrics = append(s.metrics, metric)
+}
+
+// ok counts an error, and returns true if there was not one.
+func (s *scrape) ok(err error) bool {
+	if err != nil {
+		s.errors++
+	}
+
+	return err == nil
+}
+
+// calendar returns the start and end times used to count missing items.
+func (s *scrape) calendar() (time.Time, time.Time) {
+	now := time.Now()
+	return now.AddDate(0, 0, -s.config.MissingDays), now
+}
+
+// history returns the request for the most recent history records.
+func (s *scrape) history() *starr.PageReq {
+	return &starr.PageReq{PageSize: s.config.HistoryRecords, SortKey: "date", SortDir: starr.SortDescend}
+}
+
+// counts adds one metric per key in a map of counts, with the key as the label value.
+func (s *scrape) counts(name, label string, counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s.add(name, float64(counts[key]), label, key)
+	}
+}
+
+func (s *scrape) diskSpace(disks []*starr.DiskSpace, err error) {
+	if !s.ok(err) {
+		return
+	}
+
+	for _, disk := range disks {
+		s.add("disk_free_bytes", float64(disk.FreeSpace), "path", disk.Path)
+		s.add("disk_total_bytes", float64(disk.TotalSpace), "path", disk.Path)
+	}
+}
+
+func (s *scrape) health(issues []*starr.Health, err error) {
+	if !s.ok(err) {
+		return
+	}
+
+	type key struct{ source, kind string }
+
+	counts := make(map[key]int)
+	for _, issue := range issues {
+		counts[key{issue.Source, issue.Type}]++
+	}
+
+	for key, count := range counts {
+		s.add("health_issues", float64(count), "source", key.source, "type", key.kind)
+	}
+}
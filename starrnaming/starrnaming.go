@@ -0,0 +1,302 @@
+// Package starrnaming parses, validates and renders the file and folder naming formats
+// the Starr apps use, ie. "{Movie Title} ({Release Year}) {Quality Full}".
+// Use it to lint a format before you send it to UpdateNaming, or to preview the
+// names a format produces for the items already in an app.
+//
+// Rendering follows the apps' token rules: casing, word separators, prefixes and
+// suffixes inside the braces, number padding and truncation. It does not replace
+// illegal characters or apply the colon replacement setting; the apps do that last.
+package starrnaming
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Errors returned when parsing and validating a format.
+var (
+	ErrInvalidFormat   = errors.New("invalid naming format")
+	ErrUnknownToken    = errors.New("unknown naming token")
+	ErrInvalidModifier = errors.New("invalid naming token modifier")
+	ErrUnsupportedApp  = errors.New("naming formats are not supported for this app")
+)
+
+// tokenRegex matches the tokens in a format, and the escaped braces around them.
+// This is the apps' own token expression, including Radarr's {imdb-{ImdbId}} style.
+var tokenRegex = regexp.MustCompile( //nolint:gochecknoglobals
+	`(?i)\{\{|\}\}|\{((?:imdb|tmdb|tvdb|edition)-\{)?([- ._\[(]*)` +
+		`([a-z0-9]+)(?:([- ._]+)([a-z0-9]+))?(?::([^{}]+?))?([- ._)\]]*)\}(\})?`)
+
+// These are the submatch groups in tokenRegex.
+const (
+	groupNested = iota + 1
+	groupPrefix
+	groupWord
+	groupSeparator
+	groupSecondWord
+	groupFormat
+	groupSuffix
+	groupClose
+)
+
+// Case is the letter case a token's value is rendered in. It's chosen by the case of the token.
+type Case int
+
+// These are the letter cases a token may be rendered in.
+const (
+	// CaseDefault is used when a token is written in mixed case, ie. {Movie Title}.
+	CaseDefault Case = iota
+	// CaseLower is used when a token is written in lower case, ie. {movie title}.
+	CaseLower
+	// CaseUpper is used when a token is written in upper case, ie. {MOVIE TITLE}.
+	CaseUpper
+)
+
+// Token is one {token} in a naming format.
+type Token struct {
+	// Raw is the token as it appears in the format, including braces.
+	Raw string
+	// Name is the token name with its words separated by a space, ie. Movie Title.
+	Name string
+	// Prefix is written before the value, ie. "[" in {[Quality Full]}, or "imdb-" in {imdb-{ImdbId}}.
+	Prefix string
+	// Suffix is written after the value, ie. "]" in {[Quality Full]}.
+	Suffix string
+	// Separator replaces the spaces in the value, ie. "." in {Movie.Title}.
+	Separator string
+	// Format is the custom format after the colon, ie. "00" in {season:00}.
+	Format string
+	// Case is chosen by the letter case of the token name.
+	Case Case
+}
+
+// Format is a parsed naming format. It's a list of literal text and tokens.
+type Format struct {
+	raw   string
+	parts []*part
+}
+
+// part is either a literal string, or a token.
+type part struct {
+	text  string
+	token *Token
+}
+
+// Values are the token values used to render a format.
+// The keys are token names, ie. "Movie Title", and they are matched without regard to case.
+// Numbers should be provided without padding; the token's format pads them.
+type Values map[string]string
+
+// Parse a naming format into tokens. Returns ErrInvalidFormat if a brace is not part of a token.
+// Parse does not know which tokens an app supports; use Validate for that.
+func Parse(format string) (*Format, error) {
+	output := &Format{raw: format}
+	last := 0
+
+	for _, loc := range tokenRegex.FindAllStringSubmatchIndex(format, -1) {
+		if err := output.addText(format[last:loc[0]]); err != nil {
+			return nil, err
+		}
+
+		last = loc[1]
+
+		switch match := format[loc[0]:loc[1]]; match {
+		case "{{":
+			output.parts = append(output.parts, &part{text: "{"})
+		case "}}":
+			output.parts = append(output.parts, &part{text: "}"})
+		default:
+			token, err := newToken(format, loc)
+			if err != nil {
+				return nil, err
+			}
+
+			output.parts = append(output.parts, &part{token: token})
+		}
+	}
+
+	if err := output.addText(format[last:]); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// addText adds literal text to a format. Braces in literal text are not valid.
+func (f *Format) addText(text string) error {
+	if idx := strings.IndexAny(text, "{}"); idx != -1 {
+		return fmt.Errorf("%w: unmatched brace in %q", ErrInvalidFormat, text[idx:])
+	}
+
+	if text != "" {
+		f.parts = append(f.parts, &part{text: text})
+	}
+
+	return nil
+}
+
+// newToken creates a token from the submatch indexes of a tokenRegex match.
+func newToken(format string, loc []int) (*Token, error) {
+	group := func(idx int) string {
+		if loc[idx*2] == -1 {
+			return ""
+		}
+
+		return format[loc[idx*2]:loc[idx*2+1]]
+	}
+
+	words := []string{group(groupWord)}
+	if word := group(groupSecondWord); word != "" {
+		words = append(words, word)
+	}
+
+	token := &Token{
+		Raw:       group(0),
+		Name:      strings.Join(words, " "),
+		Prefix:    group(groupPrefix),
+		Separator: group(groupSeparator),
+		Format:    group(groupFormat),
+		Suffix:    group(groupSuffix),
+		Case:      tokenCase(strings.Join(words, "")),
+	}
+
+	// The nested style, {imdb-{ImdbId}}, must open and close twice.
+	if nested := group(groupNested); nested != "" {
+		if group(groupClose) == "" {
+			return nil, fmt.Errorf("%w: unmatched brace in %q", ErrInvalidFormat, token.Raw)
+		}
+
+		token.Prefix = strings.TrimSuffix(nested, "{") + token.Prefix
+	} else if group(groupClose) != "" {
+		return nil, fmt.Errorf("%w: unmatched brace in %q", ErrInvalidFormat, token.Raw)
+	}
+
+	return token, nil
+}
+
+// tokenCase returns the case a token is rendered in, like the apps choose it.
+func tokenCase(name string) Case {
+	switch {
+	case strings.ToLower(name) == name && strings.ToUpper(name) != name:
+		return CaseLower
+	case strings.ToUpper(name) == name && strings.ToLower(name) != name:
+		return CaseUpper
+	default:
+		return CaseDefault
+	}
+}
+
+// String returns the format as it was parsed.
+func (f *Format) String() string {
+	return f.raw
+}
+
+// Tokens returns the tokens in a format, in the order they appear.
+func (f *Format) Tokens() []*Token {
+	tokens := []*Token{}
+
+	for _, part := range f.parts {
+		if part.token != nil {
+			tokens = append(tokens, part.token)
+		}
+	}
+
+	return tokens
+}
+
+// Render a format with the provided values. Tokens without a value are removed with their
+// prefix and suffix, and runs of repeated separators left behind are collapsed.
+func (f *Format) Render(values Values) string {
+	lower := make(map[string]string, len(values))
+	for name, value := range values {
+		lower[strings.ToLower(name)] = value
+	}
+
+	var output strings.Builder
+
+	for _, part := range f.parts {
+		if part.token == nil {
+			output.WriteString(part.text)
+			continue
+		}
+
+		if value := part.token.render(lower[strings.ToLower(part.token.Name)]); value != "" {
+			output.WriteString(part.token.Prefix + value + part.token.Suffix)
+		}
+	}
+
+	return cleanup(output.String())
+}
+
+// render applies a token's format, case and separator to a value.
+func (t *Token) render(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	value = t.format(value)
+
+	switch t.Case {
+	case CaseLower:
+		value = strings.ToLower(value)
+	case CaseUpper:
+		value = strings.ToUpper(value)
+	case CaseDefault:
+	}
+
+	if t.Separator != "" && t.Separator != " " {
+		value = strings.ReplaceAll(value, " ", t.Separator)
+	}
+
+	return value
+}
+
+// format pads a number with zeros, ie. {season:00}, or truncates text, ie. {Episode Title:30}.
+// Truncated text ends with an ellipsis, and a negative truncation keeps the end of the text.
+// Other formats, ie. a language code, do not change the value.
+func (t *Token) format(value string) string {
+	switch {
+	case t.Format == "":
+		return value
+	case strings.Trim(t.Format, "0") == "":
+		if number, err := strconv.Atoi(value); err == nil {
+			return fmt.Sprintf("%0*d", len(t.Format), number)
+		}
+	case isInteger(t.Format):
+		length, _ := strconv.Atoi(t.Format)
+		runes := []rune(value)
+
+		if length > 0 && length < len(runes) {
+			return strings.TrimSpace(string(runes[:length])) + "…"
+		} else if length < 0 && len(runes)+length > 0 {
+			return "…" + strings.TrimSpace(string(runes[len(runes)+length:]))
+		}
+	}
+
+	return value
+}
+
+// isInteger returns true if a string is a whole number, and may be negative.
+func isInteger(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+// cleanup collapses repeated separators, ie. "Title..2019", and trims them from the ends of a name.
+// This is what the apps do after a token without a value is removed.
+func cleanup(name string) string {
+	var output strings.Builder
+
+	for idx, char := range name {
+		if idx > 0 && strings.ContainsRune("-._ ", char) && rune(name[idx-1]) == char {
+			continue
+		}
+
+		output.WriteRune(char)
+	}
+
+	return strings.Trim(output.String(), "-._ ")
+}
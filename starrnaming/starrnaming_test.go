@@ -0,0 +1,225 @@
+package starrnaming_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrnaming"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	format, err := starrnaming.Parse("{Movie.Title}.{Release Year} {[QUALITY FULL]}{-release group} {imdb-{ImdbId}} {{x}}")
+	require.NoError(t, err)
+	assert.Equal(t, "{Movie.Title}.{Release Year} {[QUALITY FULL]}{-release group} {imdb-{ImdbId}} {{x}}", format.String())
+
+	tokens := format.Tokens()
+	require.Len(t, tokens, 5)
+	assert.Equal(t, &starrnaming.Token{Raw: "{Movie.Title}", Name: "Movie Title", Separator: "."}, tokens[0])
+	assert.Equal(t, &starrnaming.Token{Raw: "{Release Year}", Name: "Release Year", Separator: " "}, tokens[1])
+	assert.Equal(t, &starrnaming.Token{
+		Raw: "{[QUALITY FULL]}", Name: "QUALITY FULL", Prefix: "[", Suffix: "]", Separator: " ", Case: starrnaming.CaseUpper,
+	}, tokens[2])
+	assert.Equal(t, &starrnaming.Token{
+		Raw: "{-release group}", Name: "release group", Prefix: "-", Separator: " ", Case: starrnaming.CaseLower,
+	}, tokens[3])
+	assert.Equal(t, &starrnaming.Token{Raw: "{imdb-{ImdbId}}", Name: "ImdbId", Prefix: "imdb-"}, tokens[4])
+
+	for _, bad := range []string{"{Movie Title", "Movie Title}", "{Movie Title}}", "{imdb-{ImdbId}", "{}"} {
+		_, err := starrnaming.Parse(bad)
+		assert.ErrorIs(t, err, starrnaming.ErrInvalidFormat, bad)
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	values := starrnaming.Values{
+		"movie title":   "The Movie Title",
+		"Release Year":  "2019",
+		"Quality Full":  "Bluray-1080p Proper",
+		"Release Group": "GROUP",
+		"Season":        "1",
+		"Episode":       "2",
+		"Episode Title": "A Very Long Episode Title",
+		"ImdbId":        "tt1234567",
+	}
+
+	tests := map[string]string{
+		"{Movie Title} ({Release Year}) {Quality Full}":         "The Movie Title (2019) Bluray-1080p Proper",
+		"{Movie.Title}.{Release.Year}.{Quality.Full}-{Edition}": "The.Movie.Title.2019.Bluray-1080p.Proper",
+		"{movie title} {MOVIE TITLE}":                           "the movie title THE MOVIE TITLE",
+		"{Movie Title} {[Edition Tags]} {[Quality Full]}":       "The Movie Title [Bluray-1080p Proper]",
+		"{Movie Title} {imdb-{ImdbId}}{-Release Group}":         "The Movie Title imdb-tt1234567-GROUP",
+		"S{season:00}E{episode:000} - {Episode Title:11}":       "S01E002 - A Very Long…",
+		"{Episode Title:-5}":                                    "…Title",
+		"{Episode Title:-9223372036854775808}":                  "A Very Long Episode Title",
+		"{Episode Title:9223372036854775807}":                   "A Very Long Episode Title",
+		"{Movie.Title}.{Edition.Tags}.{Quality.Full}":           "The.Movie.Title.Bluray-1080p.Proper",
+		"{{Movie Title}} {Movie Title}":                         "{Movie Title} The Movie Title",
+	}
+
+	for format, expected := range tests {
+		parsed, err := starrnaming.Parse(format)
+		require.NoError(t, err, format)
+		assert.Equal(t, expected, parsed.Render(values), format)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := map[starr.App][]string{
+		starr.Radarr: {
+			"{Movie Title} ({Release Year}) {Quality Full}",
+			"{Movie.CleanTitle}.{Release.Year}.{Edition.Tags}.{Quality.Full}-{Release Group}",
+			"{Movie Title:DE} {Movie Title:30} {imdb-{ImdbId}} {Custom Format:HDR}",
+			"{movie title} {MOVIE TITLE}",
+		},
+		starr.Sonarr: {
+			"{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+			"{Series TitleYear} - {Air-Date} - {Episode Title:30}",
+			"Season {season}",
+		},
+		starr.Lidarr: {
+			"{Album Title} ({Release Year})/{Artist Name} - {Album Title} - {track:00} - {Track Title}",
+		},
+		starr.Readarr: {
+			"{Author Name}/{Book Title}/{Book Title}{ (PartNumber:00)}",
+			"{Book Title} - {PartNumber:00}",
+		},
+	}
+
+	for app, formats := range valid {
+		for _, format := range formats {
+			assert.NoError(t, starrnaming.Validate(app, format), format)
+		}
+	}
+
+	err := starrnaming.Validate(starr.Radarr, "{Series Title} {Movie title} {Movie  Title} {Quality Full:30} {Movie Title:x}")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, starrnaming.ErrUnknownToken)
+	assert.ErrorIs(t, err, starrnaming.ErrInvalidModifier)
+	assert.Contains(t, err.Error(), "{Series Title}")
+	assert.Contains(t, err.Error(), "{Movie title}")
+	assert.Contains(t, err.Error(), "{Movie  Title}")
+	assert.Contains(t, err.Error(), "{Quality Full:30}")
+	assert.Contains(t, err.Error(), "{Movie Title:x}")
+
+	err = starrnaming.Validate(starr.Sonarr, "{Series Title} S{season:2}")
+	assert.ErrorIs(t, err, starrnaming.ErrInvalidModifier)
+	assert.False(t, errors.Is(err, starrnaming.ErrUnknownToken))
+
+	err = starrnaming.Validate(starr.Radarr, "{Movie Title:-9223372036854775808}")
+	assert.ErrorIs(t, err, starrnaming.ErrInvalidModifier)
+
+	err = starrnaming.Validate(starr.Sonarr, "{Episode Title:3000000000}")
+	assert.ErrorIs(t, err, starrnaming.ErrInvalidModifier)
+
+	err = starrnaming.Validate(starr.Prowlarr, "{Movie Title}")
+	assert.ErrorIs(t, err, starrnaming.ErrUnsupportedApp)
+}
+
+func TestMovieValues(t *testing.T) {
+	t.Parallel()
+
+	movie := &radarr.Movie{
+		Title:      "The Movie: Title",
+		Year:       2019,
+		ImdbID:     "tt1234567",
+		TmdbID:     1234,
+		Collection: &radarr.Collection{Name: "Movie Collection"},
+		MovieFile: &radarr.MovieFile{
+			Quality: &starr.Quality{
+				Quality:  &starr.BaseQuality{Name: "Bluray-1080p"},
+				Revision: &starr.QualityRevision{Version: 2},
+			},
+			Edition:          "Directors Cut",
+			ReleaseGroup:     "GROUP",
+			OriginalFilePath: `C:\Downloads\The.Movie.Title.2019.1080p.BluRay.x264-GROUP.mkv`,
+			CustomFormats: []*radarr.CustomFormatOutput{
+				{Name: "HDR", IncludeCFWhenRenaming: true},
+				{Name: "Ignored"},
+			},
+			MediaInfo: &radarr.MediaInfo{VideoCodec: "x264", AudioCodec: "DTS", AudioChannels: 5.1},
+		},
+	}
+
+	values := starrnaming.MovieValues(movie)
+	assert.Equal(t, "The Movie: Title", values["Movie Title"])
+	assert.Equal(t, "The Movie Title", values["Movie CleanTitle"])
+	assert.Equal(t, "Movie: Title, The", values["Movie TitleThe"])
+	assert.Equal(t, "M", values["Movie TitleFirstCharacter"])
+	assert.Equal(t, "Bluray-1080p Proper", values["Quality Full"])
+	assert.Equal(t, "HDR", values["Custom Formats"])
+	assert.Equal(t, "The.Movie.Title.2019.1080p.BluRay.x264-GROUP", values["Original Filename"])
+	assert.Equal(t, "5.1", values["MediaInfo AudioChannels"])
+
+	format, err := starrnaming.Parse("{Movie CleanTitle} ({Release Year}) {Edition Tags} {[Custom Formats]}" +
+		"{[Quality Full]}{-Release Group} {tmdb-{TmdbId}}")
+	require.NoError(t, err)
+	assert.Equal(t, "The Movie Title (2019) Directors Cut [HDR][Bluray-1080p Proper]-GROUP tmdb-1234", format.Render(values))
+	assert.Empty(t, starrnaming.MovieValues(nil))
+}
+
+func TestEpisodeValues(t *testing.T) {
+	t.Parallel()
+
+	series := &sonarr.Series{Title: "A Series", Year: 2005, TvdbID: 76107}
+	episode := &sonarr.Episode{SeasonNumber: 0, EpisodeNumber: 3, Title: "Episode Title", AirDate: "2005-03-26"}
+	file := &sonarr.EpisodeFile{
+		Quality:      &starr.Quality{Quality: &starr.BaseQuality{Name: "HDTV-720p"}},
+		ReleaseGroup: "GROUP",
+	}
+
+	values := starrnaming.EpisodeValues(series, episode, file)
+	assert.Equal(t, "A Series (2005)", values["Series TitleYear"])
+	assert.Equal(t, "Series, A (2005)", values["Series TitleTheYear"])
+	assert.Equal(t, "A Series 2005", values["Series CleanTitleYear"])
+
+	format, err := starrnaming.Parse("{Series.Title}.S{season:00}E{episode:00}.{Episode.CleanTitle}.{Quality.Full}-{Release Group}")
+	require.NoError(t, err)
+	assert.Equal(t, "A.Series.S00E03.Episode.Title.HDTV-720p-GROUP", format.Render(values))
+
+	episode.Series = series
+	assert.Equal(t, values, starrnaming.EpisodeValues(nil, episode, file))
+}
+
+func TestTrackValues(t *testing.T) {
+	t.Parallel()
+
+	artist := &lidarr.Artist{ArtistName: "The Artist", Genres: []string{"Rock"}}
+	album := &lidarr.Album{
+		Title:       "Album Title",
+		AlbumType:   "Album",
+		ReleaseDate: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		Media:       []*lidarr.Media{{MediumNumber: 1, MediumName: "Disc 1", MediumFormat: "CD"}},
+	}
+	track := &lidarr.Track{
+		Title:               "Track Title",
+		MediumNumber:        1,
+		AbsoluteTrackNumber: 7,
+		TrackFile: &lidarr.TrackFile{
+			Path:      "/music/The Artist/Album Title/07 - Track Title.flac",
+			Quality:   &starr.Quality{Quality: &starr.BaseQuality{Name: "FLAC"}},
+			MediaInfo: lidarr.MediaInfo{AudioCodec: "FLAC", AudioChannels: 2},
+		},
+	}
+
+	values := starrnaming.TrackValues(artist, album, track)
+	assert.Equal(t, "Artist, The", values["Artist NameThe"])
+	assert.Equal(t, "CD", values["Medium Format"])
+	assert.Equal(t, "07 - Track Title", values["Original Filename"])
+
+	format, err := starrnaming.Parse("{Artist Name}/{Album Title} ({Release Year})/{medium:0}-{track:00} - {Track Title} [{Quality Title}]")
+	require.NoError(t, err)
+	assert.Equal(t, "The Artist/Album Title (2020)/1-07 - Track Title [FLAC]", format.Render(values))
+}
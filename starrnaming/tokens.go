@@ -0,0 +1,282 @@
+package starrnaming
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golift.io/starr"
+)
+
+// kind is the type of value a token has. It decides which custom formats a token accepts.
+type kind int
+
+const (
+	// kindText values may be truncated, ie. {Episode Title:30}.
+	kindText kind = iota
+	// kindNumber values may be padded with zeros, ie. {season:00}.
+	kindNumber
+	// kindTitle values may be truncated, or translated with a language code, ie. {Movie Title:DE}.
+	kindTitle
+	// kindList values accept a filter, ie. {Custom Format:HDR} or {MediaInfo AudioLanguages:EN+DE}.
+	kindList
+	// kindFixed values do not accept a custom format.
+	kindFixed
+)
+
+// These check the custom format on a token.
+var ( //nolint:gochecknoglobals
+	numberFormatRegex   = regexp.MustCompile(`^0+$`)
+	truncateFormatRegex = regexp.MustCompile(`^-?[1-9]\d*$`)
+	languageFormatRegex = regexp.MustCompile(`^[a-zA-Z]{2}$`)
+)
+
+// These are the tokens shared by every app.
+var commonTokens = map[string]kind{ //nolint:gochecknoglobals
+	"Quality Full":      kindFixed,
+	"Quality Title":     kindFixed,
+	"Quality Proper":    kindFixed,
+	"Quality Real":      kindFixed,
+	"Release Group":     kindText,
+	"Original Title":    kindText,
+	"Original Filename": kindText,
+	"Custom Formats":    kindList,
+	"Custom Format":     kindList,
+}
+
+// These are the tokens each app supports, in addition to the common tokens.
+// The names are written the way the apps' documentation writes them.
+var appTokens = map[starr.App]map[string]kind{ //nolint:gochecknoglobals
+	starr.Radarr: {
+		"Release Year":                    kindFixed,
+		"Movie Title":                     kindTitle,
+		"Movie CleanTitle":                kindTitle,
+		"Movie TitleThe":                  kindTitle,
+		"Movie CleanTitleThe":             kindTitle,
+		"Movie TitleFirstCharacter":       kindFixed,
+		"Movie OriginalTitle":             kindText,
+		"Movie CleanOriginalTitle":        kindText,
+		"Movie Collection":                kindText,
+		"Movie Certification":             kindFixed,
+		"ImdbId":                          kindFixed,
+		"TmdbId":                          kindFixed,
+		"Edition Tags":                    kindText,
+		"MediaInfo Simple":                kindFixed,
+		"MediaInfo Full":                  kindFixed,
+		"MediaInfo AudioCodec":            kindFixed,
+		"MediaInfo AudioChannels":         kindFixed,
+		"MediaInfo AudioLanguages":        kindList,
+		"MediaInfo AudioLanguagesAll":     kindList,
+		"MediaInfo SubtitleLanguages":     kindList,
+		"MediaInfo SubtitleLanguagesAll":  kindList,
+		"MediaInfo VideoCodec":            kindFixed,
+		"MediaInfo VideoBitDepth":         kindFixed,
+		"MediaInfo VideoDynamicRange":     kindFixed,
+		"MediaInfo VideoDynamicRangeType": kindFixed,
+		"MediaInfo 3D":                    kindFixed,
+	},
+	starr.Sonarr: {
+		"Series Title":                    kindText,
+		"Series CleanTitle":               kindText,
+		"Series TitleYear":                kindText,
+		"Series CleanTitleYear":           kindText,
+		"Series TitleWithoutYear":         kindText,
+		"Series CleanTitleWithoutYear":    kindText,
+		"Series TitleThe":                 kindText,
+		"Series CleanTitleThe":            kindText,
+		"Series TitleTheYear":             kindText,
+		"Series CleanTitleTheYear":        kindText,
+		"Series TitleTheWithoutYear":      kindText,
+		"Series CleanTitleTheWithoutYear": kindText,
+		"Series TitleFirstCharacter":      kindFixed,
+		"Series Year":                     kindFixed,
+		"ImdbId":                          kindFixed,
+		"TvdbId":                          kindFixed,
+		"TvMazeId":                        kindFixed,
+		"TmdbId":                          kindFixed,
+		"Season":                          kindNumber,
+		"Episode":                         kindNumber,
+		"Absolute":                        kindNumber,
+		"Air Date":                        kindFixed,
+		"Episode Title":                   kindText,
+		"Episode CleanTitle":              kindText,
+		"Release Hash":                    kindFixed,
+		"Preferred Words":                 kindText,
+		"MediaInfo Simple":                kindFixed,
+		"MediaInfo Full":                  kindFixed,
+		"MediaInfo AudioCodec":            kindFixed,
+		"MediaInfo AudioChannels":         kindFixed,
+		"MediaInfo AudioLanguages":        kindList,
+		"MediaInfo AudioLanguagesAll":     kindList,
+		"MediaInfo SubtitleLanguages":     kindList,
+		"MediaInfo SubtitleLanguagesAll":  kindList,
+		"MediaInfo VideoCodec":            kindFixed,
+		"MediaInfo VideoBitDepth":         kindFixed,
+		"MediaInfo VideoDynamicRange":     kindFixed,
+		"MediaInfo VideoDynamicRangeType": kindFixed,
+		"MediaInfo 3D":                    kindFixed,
+	},
+	starr.Lidarr: {
+		"Release Year":                 kindFixed,
+		"Artist Name":                  kindText,
+		"Artist CleanName":             kindText,
+		"Artist NameThe":               kindText,
+		"Artist CleanNameThe":          kindText,
+		"Artist NameFirstCharacter":    kindFixed,
+		"Artist Disambiguation":        kindText,
+		"Artist Genre":                 kindText,
+		"Artist MbId":                  kindFixed,
+		"Album Title":                  kindText,
+		"Album CleanTitle":             kindText,
+		"Album TitleThe":               kindText,
+		"Album CleanTitleThe":          kindText,
+		"Album Type":                   kindFixed,
+		"Album Disambiguation":         kindText,
+		"Album Genre":                  kindText,
+		"Album MbId":                   kindFixed,
+		"Medium":                       kindNumber,
+		"Medium Name":                  kindText,
+		"Medium Format":                kindFixed,
+		"Track":                        kindNumber,
+		"Track Title":                  kindText,
+		"Track CleanTitle":             kindText,
+		"Track ArtistName":             kindText,
+		"Track ArtistCleanName":        kindText,
+		"Track ArtistNameThe":          kindText,
+		"Track ArtistCleanNameThe":     kindText,
+		"Track ArtistMbId":             kindFixed,
+		"MediaInfo AudioCodec":         kindFixed,
+		"MediaInfo AudioChannels":      kindFixed,
+		"MediaInfo AudioBitRate":       kindFixed,
+		"MediaInfo AudioBitsPerSample": kindFixed,
+		"MediaInfo AudioSampleRate":    kindFixed,
+	},
+	starr.Readarr: {
+		"Release Year":              kindFixed,
+		"Author Name":               kindText,
+		"Author CleanName":          kindText,
+		"Author NameThe":            kindText,
+		"Author CleanNameThe":       kindText,
+		"Author SortName":           kindText,
+		"Author NameFirstCharacter": kindFixed,
+		"Author Disambiguation":     kindText,
+		"Book Title":                kindText,
+		"Book CleanTitle":           kindText,
+		"Book TitleThe":             kindText,
+		"Book CleanTitleThe":        kindText,
+		"Book TitleNoSub":           kindText,
+		"Book CleanTitleNoSub":      kindText,
+		"Book Subtitle":             kindText,
+		"Book Series":               kindText,
+		"Book SeriesPosition":       kindFixed,
+		"Book SeriesTitle":          kindText,
+		"Book Disambiguation":       kindText,
+		"Edition Year":              kindFixed,
+		"Edition Title":             kindText,
+		"PartNumber":                kindNumber,
+		"PartCount":                 kindNumber,
+		"MediaInfo AudioCodec":      kindFixed,
+		"MediaInfo AudioChannels":   kindFixed,
+		"MediaInfo AudioBitRate":    kindFixed,
+		"MediaInfo AudioSampleRate": kindFixed,
+	},
+}
+
+// Validate parses a naming format and checks it with Format.Validate.
+func Validate(app starr.App, format string) error {
+	parsed, err := Parse(format)
+	if err != nil {
+		return err
+	}
+
+	return parsed.Validate(app)
+}
+
+// Validate checks that every token in a format is supported by the app, and that the
+// token's casing, word separator and custom format are valid. All of the problems are
+// returned joined together; use errors.Is with ErrUnknownToken and ErrInvalidModifier.
+// Radarr, Sonarr, Lidarr and Readarr are supported.
+func (f *Format) Validate(app starr.App) error {
+	tokens, ok := appTokens[app]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedApp, app)
+	}
+
+	names := make(map[string]string, len(tokens)+len(commonTokens))
+	for _, list := range []map[string]kind{commonTokens, tokens} {
+		for name := range list {
+			names[strings.ToLower(name)] = name
+		}
+	}
+
+	var errs []error
+
+	for _, token := range f.Tokens() {
+		name, ok := names[strings.ToLower(token.Name)]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: %s does not support %s", ErrUnknownToken, app, token.Raw))
+			continue
+		}
+
+		tokenKind, ok := tokens[name]
+		if !ok {
+			tokenKind = commonTokens[name]
+		}
+
+		if err := token.validate(name, tokenKind); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validate checks a token's casing, separator and custom format. name is how the app writes the token.
+func (t *Token) validate(name string, tokenKind kind) error {
+	if written := strings.ReplaceAll(t.Name, " ", ""); t.Case == CaseDefault &&
+		written != strings.ReplaceAll(name, " ", "") {
+		return fmt.Errorf("%w: %s has mixed casing, write it as {%s}, or in all lower or upper case",
+			ErrInvalidModifier, t.Raw, name)
+	}
+
+	if len(t.Separator) > 1 {
+		return fmt.Errorf("%w: %s has more than one word separator", ErrInvalidModifier, t.Raw)
+	}
+
+	if t.Format == "" {
+		return nil
+	}
+
+	var valid bool
+
+	switch tokenKind {
+	case kindNumber:
+		valid = numberFormatRegex.MatchString(t.Format)
+	case kindText:
+		valid = validTruncate(t.Format)
+	case kindTitle:
+		valid = validTruncate(t.Format) || languageFormatRegex.MatchString(t.Format)
+	case kindList:
+		valid = true
+	case kindFixed:
+	}
+
+	if !valid {
+		return fmt.Errorf("%w: %s does not accept the format %q", ErrInvalidModifier, t.Raw, t.Format)
+	}
+
+	return nil
+}
+
+// validTruncate returns true if a custom format is a truncation length, and it fits in an int32.
+func validTruncate(format string) bool {
+	if !truncateFormatRegex.MatchString(format) {
+		return false
+	}
+
+	_, err := strconv.ParseInt(format, 10, 32) //nolint:gomnd
+
+	return err == nil
+}
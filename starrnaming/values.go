@@ -0,0 +1,301 @@
+package starrnaming
+
+import (
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+)
+
+// These make the title variations the apps provide as tokens.
+var ( //nolint:gochecknoglobals
+	// titleTheRegex moves an article to the end of a title, ie. The Title (2019) becomes Title, The (2019).
+	titleTheRegex = regexp.MustCompile(`^(?i)(The|An|A) (.*?)((?: *\([^)]+\))*)$`)
+	// cleanRemoveRegex matches the characters removed from a clean title.
+	cleanRemoveRegex = regexp.MustCompile("[,<>;:'\"|`~!?@$%^*=(){}\\[\\]]")
+	// cleanSpaceRegex matches the characters replaced with a space in a clean title.
+	cleanSpaceRegex = regexp.MustCompile(`[/\\]|\s{2,}`)
+)
+
+// MovieValues returns the token values for a Radarr movie, and its movie file if it has one.
+func MovieValues(movie *radarr.Movie) Values {
+	values := Values{}
+	if movie == nil {
+		return values
+	}
+
+	values.titles("Movie Title", "Movie CleanTitle", "Movie TitleThe", "Movie CleanTitleThe", movie.Title)
+	values.set("Movie TitleFirstCharacter", firstCharacter(titleThe(movie.Title)))
+	values.set("Movie OriginalTitle", movie.OriginalTitle)
+	values.set("Movie CleanOriginalTitle", cleanTitle(movie.OriginalTitle))
+	values.set("Movie Certification", movie.Certification)
+	values.number("Release Year", int64(movie.Year))
+	values.set("ImdbId", movie.ImdbID)
+	values.number("TmdbId", movie.TmdbID)
+
+	if movie.Collection != nil {
+		values.set("Movie Collection", movie.Collection.Name)
+	}
+
+	if file := movie.MovieFile; file != nil {
+		values.quality(file.Quality)
+		values.set("Edition Tags", file.Edition)
+		values.set("Release Group", file.ReleaseGroup)
+		values.set("Original Title", file.SceneName)
+		values.set("Original Filename", baseName(file.OriginalFilePath))
+
+		formats := []string{}
+
+		for _, format := range file.CustomFormats {
+			if format.IncludeCFWhenRenaming {
+				formats = append(formats, format.Name)
+			}
+		}
+
+		values.set("Custom Formats", strings.Join(formats, " "))
+
+		if info := file.MediaInfo; info != nil {
+			values.mediaInfo(info.VideoCodec, info.AudioCodec, info.AudioChannels, info.VideoBitDepth)
+			values.set("MediaInfo VideoDynamicRangeType", info.VideoDynamicRangeType)
+		}
+	}
+
+	return values
+}
+
+// EpisodeValues returns the token values for a Sonarr episode. If series is nil, the
+// episode's series is used. file may be nil when the episode does not have a file.
+func EpisodeValues(series *sonarr.Series, episode *sonarr.Episode, file *sonarr.EpisodeFile) Values {
+	values := Values{}
+	if episode == nil {
+		return values
+	}
+
+	if series == nil {
+		series = episode.Series
+	}
+
+	if series != nil {
+		year := ""
+		if series.Year > 0 {
+			year = " (" + strconv.Itoa(series.Year) + ")"
+		}
+
+		values.titles("Series Title", "Series CleanTitle", "Series TitleThe", "Series CleanTitleThe", series.Title)
+		values.set("Series TitleYear", series.Title+year)
+		values.set("Series CleanTitleYear", cleanTitle(series.Title+year))
+		values.set("Series TitleTheYear", titleThe(series.Title+year))
+		values.set("Series CleanTitleTheYear", cleanTitle(titleThe(series.Title+year)))
+		values.set("Series TitleWithoutYear", series.Title)
+		values.set("Series CleanTitleWithoutYear", cleanTitle(series.Title))
+		values.set("Series TitleTheWithoutYear", titleThe(series.Title))
+		values.set("Series CleanTitleTheWithoutYear", cleanTitle(titleThe(series.Title)))
+		values.set("Series TitleFirstCharacter", firstCharacter(titleThe(series.Title)))
+		values.number("Series Year", int64(series.Year))
+		values.set("ImdbId", series.ImdbID)
+		values.number("TvdbId", series.TvdbID)
+		values.number("TvMazeId", series.TvMazeID)
+	}
+
+	values.set("Season", strconv.Itoa(episode.SeasonNumber))
+	values.set("Episode", strconv.Itoa(episode.EpisodeNumber))
+	values.number("Absolute", int64(episode.AbsoluteEpisodeNumber))
+	values.set("Air Date", episode.AirDate)
+	values.set("Episode Title", episode.Title)
+	values.set("Episode CleanTitle", cleanTitle(episode.Title))
+
+	if file != nil {
+		values.quality(file.Quality)
+		values.set("Release Group", file.ReleaseGroup)
+		values.set("Original Title", file.SceneName)
+
+		formats := []string{}
+
+		for _, format := range file.CustomFormats {
+			if format.IncludeCFWhenRenaming {
+				formats = append(formats, format.Name)
+			}
+		}
+
+		values.set("Custom Formats", strings.Join(formats, " "))
+
+		if info := file.MediaInfo; info != nil {
+			values.mediaInfo(info.VideoCodec, info.AudioCodec, info.AudioChannels, info.VideoBitDepth)
+		}
+	}
+
+	return values
+}
+
+// TrackValues returns the token values for a Lidarr track, and its track file if it has one.
+// If artist is nil, the track's artist is used.
+func TrackValues(artist *lidarr.Artist, album *lidarr.Album, track *lidarr.Track) Values {
+	values := Values{}
+	if track == nil {
+		return values
+	}
+
+	if artist == nil {
+		artist = track.Artist
+	}
+
+	if artist != nil {
+		values.titles("Artist Name", "Artist CleanName", "Artist NameThe", "Artist CleanNameThe", artist.ArtistName)
+		values.set("Artist NameFirstCharacter", firstCharacter(titleThe(artist.ArtistName)))
+		values.set("Artist Disambiguation", artist.Disambiguation)
+		values.set("Artist MbId", artist.ForeignArtistID)
+
+		if len(artist.Genres) > 0 {
+			values.set("Artist Genre", artist.Genres[0])
+		}
+	}
+
+	if album != nil {
+		values.titles("Album Title", "Album CleanTitle", "Album TitleThe", "Album CleanTitleThe", album.Title)
+		values.set("Album Type", album.AlbumType)
+		values.set("Album Disambiguation", album.Disambiguation)
+		values.set("Album MbId", album.ForeignAlbumID)
+
+		if !album.ReleaseDate.IsZero() {
+			values.number("Release Year", int64(album.ReleaseDate.Year()))
+		}
+
+		if len(album.Genres) > 0 {
+			values.set("Album Genre", album.Genres[0])
+		}
+
+		for _, medium := range album.Media {
+			if medium.MediumNumber == int64(track.MediumNumber) {
+				values.set("Medium Name", medium.MediumName)
+				values.set("Medium Format", medium.MediumFormat)
+			}
+		}
+	}
+
+	values.number("Medium", int64(track.MediumNumber))
+	values.number("Track", int64(track.AbsoluteTrackNumber))
+	values.set("Track Title", track.Title)
+	values.set("Track CleanTitle", cleanTitle(track.Title))
+
+	if file := track.TrackFile; file != nil {
+		values.quality(file.Quality)
+		values.set("Original Filename", baseName(file.Path))
+		values.set("MediaInfo AudioCodec", file.MediaInfo.AudioCodec)
+		values.set("MediaInfo AudioBitRate", file.MediaInfo.AudioBitRate)
+		values.set("MediaInfo AudioBitsPerSample", file.MediaInfo.AudioBits)
+		values.set("MediaInfo AudioSampleRate", file.MediaInfo.AudioSampleRate)
+		values.number("MediaInfo AudioChannels", int64(file.MediaInfo.AudioChannels))
+	}
+
+	return values
+}
+
+// set a value if it's not empty.
+func (v Values) set(name, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		v[name] = value
+	}
+}
+
+// number sets a value if it's not zero.
+func (v Values) number(name string, value int64) {
+	if value != 0 {
+		v[name] = strconv.FormatInt(value, 10) //nolint:gomnd
+	}
+}
+
+// titles sets the title, clean title, and their "The" variations.
+func (v Values) titles(title, clean, the, cleanThe, value string) {
+	v.set(title, value)
+	v.set(clean, cleanTitle(value))
+	v.set(the, titleThe(value))
+	v.set(cleanThe, cleanTitle(titleThe(value)))
+}
+
+// quality sets the quality tokens. Proper is added for a second revision, and REAL for a real revision.
+func (v Values) quality(quality *starr.Quality) {
+	if quality == nil || quality.Quality == nil {
+		return
+	}
+
+	if rev := quality.Revision; rev != nil {
+		switch {
+		case rev.Version > 1 && rev.IsRepack:
+			v.set("Quality Proper", "Repack")
+		case rev.Version > 1:
+			v.set("Quality Proper", "Proper")
+		}
+
+		if rev.Real > 0 {
+			v.set("Quality Real", "REAL")
+		}
+	}
+
+	full := quality.Quality.Name
+	for _, tag := range []string{v["Quality Proper"], v["Quality Real"]} {
+		if tag != "" {
+			full += " " + tag
+		}
+	}
+
+	v.set("Quality Title", quality.Quality.Name)
+	v.set("Quality Full", full)
+}
+
+// mediaInfo sets the media info tokens shared by movie and episode files.
+func (v Values) mediaInfo(videoCodec, audioCodec string, channels float64, bitDepth int) {
+	v.set("MediaInfo VideoCodec", videoCodec)
+	v.set("MediaInfo AudioCodec", audioCodec)
+	v.set("MediaInfo Simple", videoCodec+" "+audioCodec)
+	v.number("MediaInfo VideoBitDepth", int64(bitDepth))
+
+	if channels > 0 {
+		v.set("MediaInfo AudioChannels", strconv.FormatFloat(channels, 'f', 1, 64)) //nolint:gomnd
+	}
+}
+
+// cleanTitle removes the characters the apps remove from their clean title tokens.
+func cleanTitle(title string) string {
+	title = strings.ReplaceAll(title, "&", "and")
+	title = cleanRemoveRegex.ReplaceAllString(title, "")
+
+	return strings.TrimSpace(cleanSpaceRegex.ReplaceAllString(title, " "))
+}
+
+// titleThe moves a leading article to the end of a title, ie. The Title becomes Title, The.
+func titleThe(title string) string {
+	return titleTheRegex.ReplaceAllString(title, "$2, $1$3")
+}
+
+// firstCharacter returns the first letter of a title in upper case, or an underscore
+// if the title starts with something else.
+func firstCharacter(title string) string {
+	if title == "" {
+		return ""
+	}
+
+	if char, _ := utf8.DecodeRuneInString(title); unicode.IsLetter(char) || unicode.IsDigit(char) {
+		return string(unicode.ToUpper(char))
+	}
+
+	return "_"
+}
+
+// baseName returns a file name without its directory or extension.
+func baseName(filePath string) string {
+	if filePath == "" {
+		return ""
+	}
+
+	// The app may run on Windows, so both separators are handled.
+	base := path.Base(strings.ReplaceAll(filePath, `\`, "/"))
+
+	return strings.TrimSuffix(base, path.Ext(base))
+}
@@ -0,0 +1,306 @@
+package starrparse
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"golift.io/starr/sonarr"
+)
+
+// These are the release types Sonarr returns in ParsedEpisodeInfo.
+const (
+	ReleaseTypeUnknown       = "unknown"
+	ReleaseTypeSingleEpisode = "singleEpisode"
+	ReleaseTypeMultiEpisode  = "multiEpisode"
+	ReleaseTypeSeasonPack    = "seasonPack"
+)
+
+// These match the season and episode numbers in a title. They're tried in the order they're used in episodeMatchers.
+var ( //nolint:gochecknoglobals
+	// standardRegex matches S01E01, S01E01-E03 and S01E01E02. The extra episodes are matched by standardNextRegex.
+	standardRegex     = regexp.MustCompile(`(?i)^(?P<title>.*?)(?:^|[-_. ]+)S(?P<season>\d{1,4})[-_. ]?E(?P<episode>\d{1,4})`)
+	standardNextRegex = regexp.MustCompile(`(?i)^(?:-?E|[-_. ]E|-)(\d{1,4})([pi]?)`)
+	// wordsRegex matches Season 1 Episode 5.
+	wordsRegex = regexp.MustCompile(`(?i)^(?P<title>.+?)[-_. ]+Season[-_. ]?(?P<season>\d{1,4})[-_. ]+` +
+		`Episode[-_. ]?(?P<episode>\d{1,4})`)
+	// crossRegex matches 1x05 and 1x05-06. The extra episodes are matched by crossNextRegex.
+	crossRegex     = regexp.MustCompile(`(?i)^(?P<title>.*?)(?:^|[-_. ]+)(?P<season>\d{1,2})x(?P<episode>\d{2,3})`)
+	crossNextRegex = regexp.MustCompile(`(?i)^(?:-|[-_. ]*x)(\d{2,3})([pi]?)`)
+	// multiSeasonRegex matches S01-S03.
+	multiSeasonRegex = regexp.MustCompile(`(?i)^(?P<title>.+?)[-_. ]+S(?P<season>\d{1,2})[-_. ]?(?:-|to)[-_. ]?` +
+		`S(?P<last>\d{1,2})(?:[-_. ]|$)`)
+	// dailyRegex matches 2011.04.18 and 2011-04-18.
+	dailyRegex = regexp.MustCompile(`(?i)^(?P<title>.*?)(?:^|[-_. ]+)\(?(?P<year>(?:19|20)\d{2})[-_. ]` +
+		`(?P<month>0[1-9]|1[0-2])[-_. ](?P<day>0[1-9]|[12]\d|3[01])\)?(?:[-_. ]|$)`)
+	// threeDigitRegex matches 103 (season 1, episode 3) when it's followed by quality information.
+	threeDigitRegex = regexp.MustCompile(`(?i)^(?P<title>.+?)[-_. ]+(?P<season>[1-9])(?P<episode>\d{2})[-_. ]+` +
+		`(?:\d{3,4}[pi]|HDTV|PDTV|SDTV|WEB|DVD|Blu-?Ray|BDRip|xvid|x264)\b`)
+	// animeDashRegex matches the absolute episode in an anime title, ie. [SubsPlease] Title - 01 (1080p).
+	animeDashRegex = regexp.MustCompile(`(?i)^(?P<title>.+?)[-_. ]+-[-_. ]+(?:Episode[-_. ]?|Ep[-_. ]?|E)?` +
+		`(?P<episode>\d{2,4})(?:v\d)?(?:[-_. ]?[-~][-_. ]?(?P<last>\d{2,4})(?:v\d)?)?(?:[-_. \[(]|$)`)
+	// animeRegex matches the absolute episode in an anime title without a dash, ie. [SubDESU] Title 07 (1280x720).
+	animeRegex = regexp.MustCompile(`(?i)^(?P<title>.+?)[-_. ]+(?:Episode[-_. ]?|Ep[-_. ]?|E)?` +
+		`(?P<episode>\d{2,4})(?:v\d)?(?:[-_. ]?[-~][-_. ]?(?P<last>\d{2,4})(?:v\d)?)?(?:[-_. \[(]|$)`)
+	// seasonRegex matches a full season, ie. S01, Season 1 and S01 Part 2.
+	seasonRegex = regexp.MustCompile(`(?i)^(?P<title>.+?)[-_. ]+(?:S|Season[-_. ]?)(?P<season>\d{1,4})` +
+		`(?:[-_. ]+(?:Part|P)[-_. ]?(?P<part>\d{1,2}))?(?:[-_. ]|$)`)
+	// extrasRegex matches the extras and subtitle packs that go with a season.
+	extrasRegex = regexp.MustCompile(`(?i)\b(?:Extras|Bonus|SUBPACK)\b`)
+)
+
+// episodeMatch is the information an episode matcher found in a title.
+type episodeMatch struct {
+	title       string
+	end         int // The index in the release body where the matched information ends.
+	season      int
+	episodes    []int
+	absolute    []int
+	airDate     string
+	part        int
+	fullSeason  bool
+	multiSeason bool
+}
+
+// episodeMatchers are tried in order until one matches. The anime matchers only run for titles with a group in front.
+var episodeMatchers = []func(r *release) *episodeMatch{ //nolint:gochecknoglobals
+	matchStandard,
+	matchWords,
+	matchCross,
+	matchMultiSeason,
+	matchDaily,
+	matchThreeDigit,
+	func(r *release) *episodeMatch { return matchAnime(r, animeDashRegex) },
+	matchSeason,
+	func(r *release) *episodeMatch { return matchAnime(r, animeRegex) },
+}
+
+// ParseEpisode parses a series release title, or file name, like the Sonarr parse endpoint does.
+// Returns ErrNoMatch if the title does not have season and episode numbers, an absolute episode number,
+// an air date, or a season. Quality uses Sonarr's quality definitions.
+func ParseEpisode(title string) (*sonarr.ParsedEpisodeInfo, error) {
+	rel := newRelease(title)
+
+	for _, matcher := range episodeMatchers {
+		if match := matcher(rel); match != nil {
+			return rel.episodeInfo(match), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNoMatch, title)
+}
+
+// episodeInfo turns a match into the data Sonarr returns.
+func (r *release) episodeInfo(match *episodeMatch) *sonarr.ParsedEpisodeInfo {
+	tokens := r.body[match.end:]
+	seriesTitle := cleanTitle(match.title)
+	withoutYear, year := splitTitleYear(seriesTitle)
+
+	info := &sonarr.ParsedEpisodeInfo{
+		EpisodeNumbers:                match.episodes,
+		AbsoluteEpisodeNumbers:        match.absolute,
+		SpecialAbsoluteEpisodeNumbers: []interface{}{},
+		Languages:                     findLanguages(tokens),
+		SeasonNumber:                  match.season,
+		SeasonPart:                    int64(match.part),
+		FullSeason:                    match.fullSeason,
+		IsPartialSeason:               match.part > 0,
+		IsMultiSeason:                 match.multiSeason,
+		IsSeasonExtra:                 match.fullSeason && extrasRegex.MatchString(tokens),
+		IsDaily:                       match.airDate != "",
+		IsAbsoluteNumbering:           len(match.absolute) > 0,
+		ReleaseTitle:                  r.original,
+		SeriesTitle:                   seriesTitle,
+		ReleaseGroup:                  r.releaseGroup(),
+		ReleaseHash:                   r.hash,
+		ReleaseTokens:                 tokens,
+		ReleaseType:                   ReleaseTypeUnknown,
+		AirDate:                       match.airDate,
+		SeriesTitleInfo:               &sonarr.SeriesTitleInfo{Year: year, Title: seriesTitle, TitleWithoutYear: withoutYear},
+		Quality:                       r.quality(false).sonarr(),
+	}
+
+	if info.EpisodeNumbers == nil {
+		info.EpisodeNumbers = []int{}
+	}
+
+	if info.AbsoluteEpisodeNumbers == nil {
+		info.AbsoluteEpisodeNumbers = []int{}
+	}
+
+	switch count := len(info.EpisodeNumbers) + len(info.AbsoluteEpisodeNumbers); {
+	case info.FullSeason:
+		info.ReleaseType = ReleaseTypeSeasonPack
+	case count > 1:
+		info.ReleaseType = ReleaseTypeMultiEpisode
+	case count == 1, info.IsDaily:
+		info.ReleaseType = ReleaseTypeSingleEpisode
+	}
+
+	return info
+}
+
+// group returns a named group from a regex match, or an empty string.
+func group(regex *regexp.Regexp, match []string, name string) string {
+	if idx := regex.SubexpIndex(name); idx > 0 && idx < len(match) {
+		return match[idx]
+	}
+
+	return ""
+}
+
+// matchEpisodes matches a season and episode with one of the regexes, and then any extra episodes with the next regex.
+// Episodes are a range, so S01E01-E03 and S01E01E03 are both episodes 1, 2 and 3.
+func matchEpisodes(r *release, regex, next *regexp.Regexp) *episodeMatch {
+	match := regex.FindStringSubmatchIndex(r.body)
+	if match == nil {
+		return nil
+	}
+
+	strs := regex.FindStringSubmatch(r.body)
+	first := atoi(group(regex, strs, "episode"))
+	last, end := first, match[1]
+
+	for next != nil {
+		extra := next.FindStringSubmatch(r.body[end:])
+		// A resolution, or a longer number, is not an episode.
+		if extra == nil || extra[2] != "" || isDigitAt(r.body, end+len(extra[0])) {
+			break
+		}
+
+		last, end = atoi(extra[1]), end+len(extra[0])
+	}
+
+	if isDigitAt(r.body, end) {
+		return nil
+	}
+
+	episodes := numberRange(first, last)
+	if episodes == nil {
+		return nil
+	}
+
+	return &episodeMatch{
+		title:    group(regex, strs, "title"),
+		end:      end,
+		season:   atoi(group(regex, strs, "season")),
+		episodes: episodes,
+	}
+}
+
+// isDigitAt returns true if the character at idx is a number.
+func isDigitAt(str string, idx int) bool {
+	return idx < len(str) && str[idx] >= '0' && str[idx] <= '9'
+}
+
+func matchStandard(r *release) *episodeMatch {
+	return matchEpisodes(r, standardRegex, standardNextRegex)
+}
+
+func matchWords(r *release) *episodeMatch {
+	return matchEpisodes(r, wordsRegex, nil)
+}
+
+func matchCross(r *release) *episodeMatch {
+	return matchEpisodes(r, crossRegex, crossNextRegex)
+}
+
+func matchThreeDigit(r *release) *episodeMatch {
+	match := matchEpisodes(r, threeDigitRegex, nil)
+	if match != nil {
+		// The regex includes the quality information that follows the episode. It's not part of the match.
+		loc := threeDigitRegex.FindStringSubmatchIndex(r.body)
+		match.end = loc[2*threeDigitRegex.SubexpIndex("episode")+1]
+	}
+
+	return match
+}
+
+func matchMultiSeason(r *release) *episodeMatch {
+	strs := multiSeasonRegex.FindStringSubmatch(r.body)
+	if strs == nil {
+		return nil
+	}
+
+	first, last := atoi(group(multiSeasonRegex, strs, "season")), atoi(group(multiSeasonRegex, strs, "last"))
+	if numberRange(first, last) == nil {
+		return nil
+	}
+
+	return &episodeMatch{
+		title:       group(multiSeasonRegex, strs, "title"),
+		end:         len(strs[0]),
+		season:      first,
+		fullSeason:  true,
+		multiSeason: first != last,
+	}
+}
+
+func matchSeason(r *release) *episodeMatch {
+	strs := seasonRegex.FindStringSubmatch(r.body)
+	if strs == nil {
+		return nil
+	}
+
+	match := &episodeMatch{
+		title:  group(seasonRegex, strs, "title"),
+		end:    len(strs[0]),
+		season: atoi(group(seasonRegex, strs, "season")),
+		part:   atoi(group(seasonRegex, strs, "part")),
+	}
+	match.fullSeason = match.part == 0
+
+	return match
+}
+
+func matchDaily(r *release) *episodeMatch {
+	strs := dailyRegex.FindStringSubmatch(r.body)
+	if strs == nil {
+		return nil
+	}
+
+	year, month, day := atoi(strs[2]), atoi(strs[3]), atoi(strs[4])
+	// Make sure the date exists, ie. not February 31st.
+	if date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC); date.Day() != day {
+		return nil
+	}
+
+	return &episodeMatch{
+		title:    group(dailyRegex, strs, "title"),
+		end:      len(strs[0]),
+		episodes: []int{},
+		airDate:  fmt.Sprintf("%04d-%02d-%02d", year, month, day),
+	}
+}
+
+// matchAnime matches an absolute episode number in an anime title.
+// These titles must have the group in front, ie. [SubsPlease], or they'd match too many other titles.
+func matchAnime(r *release, regex *regexp.Regexp) *episodeMatch {
+	if r.subGroup == "" {
+		return nil
+	}
+
+	loc := regex.FindStringSubmatchIndex(r.body)
+	if loc == nil {
+		return nil
+	}
+
+	strs := regex.FindStringSubmatch(r.body)
+	first := atoi(group(regex, strs, "episode"))
+	last := first
+
+	if val := group(regex, strs, "last"); val != "" {
+		last = atoi(val)
+	}
+
+	absolute := numberRange(first, last)
+	if absolute == nil {
+		return nil
+	}
+
+	return &episodeMatch{
+		title:    group(regex, strs, "title"),
+		end:      loc[2*regex.SubexpIndex("episode")+1],
+		absolute: absolute,
+	}
+}
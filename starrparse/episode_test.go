@@ -0,0 +1,219 @@
+package starrparse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr/starrparse"
+)
+
+// episodeTests are from the Sonarr parser test fixtures, with the outputs Sonarr returns for them.
+var episodeTests = []struct {
+	title    string
+	series   string
+	season   int
+	episodes []int
+	absolute []int
+	airDate  string
+	group    string
+	quality  string
+}{
+	// Single episodes.
+	{"Sonny.With.a.Chance.S02E15", "Sonny With a Chance", 2, []int{15}, nil, "", "", "Unknown"},
+	{"Two.and.a.Half.Me.103.720p.HDTV.X264-DIMENSION", "Two and a Half Me", 1, []int{3}, nil, "", "DIMENSION", "HDTV-720p"},
+	{"Two.and.a.Half.Me.113.720p.HDTV.X264-DIMENSION", "Two and a Half Me", 1, []int{13}, nil, "", "DIMENSION", "HDTV-720p"},
+	{"Chuck.4x05.HDTV.XviD-LOL", "Chuck", 4, []int{5}, nil, "", "LOL", "SDTV"},
+	{"The.Girls.Next.Door.S03E06.DVDRip.XviD-WiDE", "The Girls Next Door", 3, []int{6}, nil, "", "WiDE", "DVD"},
+	{"Degrassi.S10E27.WS.DSR.XviD-2HD", "Degrassi", 10, []int{27}, nil, "", "2HD", "SDTV"},
+	{"Parenthood.2010.S02E14.HDTV.XviD-LOL", "Parenthood 2010", 2, []int{14}, nil, "", "LOL", "SDTV"},
+	{"Hawaii Five 0 S02E21 720p HDTV x264-DIMENSION", "Hawaii Five 0", 2, []int{21}, nil, "", "DIMENSION", "HDTV-720p"},
+	{"The Event S01E14 A Message Back 720p WEB-DL DD5.1 H.264-SURFER", "The Event", 1, []int{14}, nil, "", "SURFER", "WEBDL-720p"},
+	{"Adam Hills In Gordon St Tonight S01E07 WS PDTV XviD FUtV", "Adam Hills In Gordon St Tonight", 1, []int{7}, nil, "", "", "SDTV"},
+	{"Adventure.Inc.S03E19.DVDRip.XviD-OSiTV", "Adventure Inc", 3, []int{19}, nil, "", "OSiTV", "DVD"},
+	{"S03E09 WS PDTV XviD FUtV", "", 3, []int{9}, nil, "", "", "SDTV"},
+	{"5x10 WS PDTV XviD FUtV", "", 5, []int{10}, nil, "", "", "SDTV"},
+	{"Castle.2009.S01E14.English.HDTV.XviD-LOL", "Castle 2009", 1, []int{14}, nil, "", "LOL", "SDTV"},
+	{"Pride.and.Prejudice.1995.S03E20.HDTV.XviD-LOL", "Pride and Prejudice 1995", 3, []int{20}, nil, "", "LOL", "SDTV"},
+	{"The.Office.S03E115.DVDRip.XviD-OSiTV", "The Office", 3, []int{115}, nil, "", "OSiTV", "DVD"},
+	{"Parks and Recreation - S02E21 - 94 Meetings - 720p TV.mkv", "Parks and Recreation", 2, []int{21}, nil, "", "", "HDTV-720p"},
+	{"24-7 Penguins-Capitals- Road to the NHL Winter Classic - S01E03 - Episode 3.mkv",
+		"24-7 Penguins-Capitals- Road to the NHL Winter Classic", 1, []int{3}, nil, "", "", "HDTV-720p"},
+	{"Hawaii Five-0 (2010) - 1x05 - Nalowale (Forgotten-Missing)", "Hawaii Five-0 (2010)", 1, []int{5}, nil, "", "", "Unknown"},
+	{"House - S06E13 - 5 to 9 [DVD]", "House", 6, []int{13}, nil, "", "", "DVD"},
+	{"The Mentalist - S02E21 - 18-5-4", "The Mentalist", 2, []int{21}, nil, "", "", "Unknown"},
+	{"Breaking.In.S01E07.21.0.Jump.Street.720p.WEB-DL.DD5.1.h.264-KiNGS", "Breaking In", 1, []int{7}, nil, "", "KiNGS", "WEBDL-720p"},
+	{"The.Walking.Dead.S04E13.720p.WEB-DL.AAC2.0.H.264-Cyphanix", "The Walking Dead", 4, []int{13}, nil, "", "Cyphanix", "WEBDL-720p"},
+	{"Arrested.Development.S04E01.720p.WEBRip.AAC2.0.x264-NFRiP", "Arrested Development", 4, []int{1}, nil, "", "NFRiP", "WEBRip-720p"},
+	{"Under the Dome S01E10 Let the Games Begin 1080p", "Under the Dome", 1, []int{10}, nil, "", "", "HDTV-1080p"},
+	{"Sons.Of.Anarchy.S02E13.720p.BluRay.x264-SiNNERS", "Sons Of Anarchy", 2, []int{13}, nil, "", "SiNNERS", "Bluray-720p"},
+	{"Chuck - S01E03 - Come Fly With Me - 1080p BluRay.mkv", "Chuck", 1, []int{3}, nil, "", "", "Bluray-1080p"},
+	{"Game.of.Thrones.S01E01.1080p.BluRay.REMUX.AVC.DTS-HD.MA.5.1-EPSiLON", "Game of Thrones", 1, []int{1}, nil, "", "EPSiLON",
+		"Bluray-1080p Remux"},
+	{"Mad.Men.S05E01.1080i.HDTV.DD5.1.MPEG2-TrollHD", "Mad Men", 5, []int{1}, nil, "", "TrollHD", "Raw-HD"},
+	{"House.of.the.Dragon.S01E01.2160p.WEB-DL.DDP5.1.HDR.HEVC-NTb", "House of the Dragon", 1, []int{1}, nil, "", "NTb",
+		"WEBDL-2160p"},
+	{"The.Last.of.Us.S01E09.1080p.WEB.H264-CAKES", "The Last of Us", 1, []int{9}, nil, "", "CAKES", "WEBDL-1080p"},
+	{"Series.Title.S04E06.Episode.Name.720p.WEB-DL.DD5.1.H.264-HarrHD-RePACKPOST", "Series Title", 4, []int{6}, nil, "",
+		"HarrHD", "WEBDL-720p"},
+	{"Series.Title.S01E05.720p.HDTV.x264-KILLERS[rarbg]", "Series Title", 1, []int{5}, nil, "", "KILLERS", "HDTV-720p"},
+	{"[www.Speed.cd] - Series.Title.S01E05.720p.HDTV.x264-KILLERS", "Series Title", 1, []int{5}, nil, "", "KILLERS", "HDTV-720p"},
+	{"Series Title Season 1 Episode 5 720p", "Series Title", 1, []int{5}, nil, "", "", "HDTV-720p"},
+	{"Series.Title.S00E05.Behind.the.Scenes.480p.WEB-DL.x264-GROUP", "Series Title", 0, []int{5}, nil, "", "GROUP", "WEBDL-480p"},
+	{"Series.Title.S2021E05.720p.HDTV.x264-GROUP", "Series Title", 2021, []int{5}, nil, "", "GROUP", "HDTV-720p"},
+	// Multiple episodes.
+	{"WEEDS.S03E01-06.DUAL.Bluray.AC3.-HELLYWOOD.avi", "WEEDS", 3, []int{1, 2, 3, 4, 5, 6}, nil, "", "HELLYWOOD", "Bluray-720p"},
+	{"Hell.On.Wheels.S02E09-E10.720p.HDTV.x264-EVOLVE", "Hell On Wheels", 2, []int{9, 10}, nil, "", "EVOLVE", "HDTV-720p"},
+	{"Mad.Men.S05E01-E02.720p.HDTV.x264-2HD", "Mad Men", 5, []int{1, 2}, nil, "", "2HD", "HDTV-720p"},
+	{"The.Borgias.S01e01e02.ShareReactor.EN.HDTV.XviD-IMMERSE", "The Borgias", 1, []int{1, 2}, nil, "", "IMMERSE", "SDTV"},
+	{"Adventure Time - 5x01 - x02 - Finn the Human (2) & Jake the Dog (3)", "Adventure Time", 5, []int{1, 2}, nil, "", "",
+		"Unknown"},
+	{"Series.Title.S05E01E02E03E04.HDTV.XviD-LOL", "Series Title", 5, []int{1, 2, 3, 4}, nil, "", "LOL", "SDTV"},
+	{"Series Title - S01E01-E03 - Episode Title [WEBDL-720p]", "Series Title", 1, []int{1, 2, 3}, nil, "", "", "WEBDL-720p"},
+	{"The.Office.US.S01E01E03.720p.HDTV.x264-CTU", "The Office US", 1, []int{1, 2, 3}, nil, "", "CTU", "HDTV-720p"},
+	{"Series.Title.1x01x02.720p.HDTV", "Series Title", 1, []int{1, 2}, nil, "", "", "HDTV-720p"},
+	{"Series.Title.S01E01-720p.HDTV", "Series Title", 1, []int{1}, nil, "", "", "HDTV-720p"},
+	// Daily episodes.
+	{"Conan 2011 04 18 Emma Roberts HDTV XviD BFF", "Conan", 0, []int{}, nil, "2011-04-18", "", "SDTV"},
+	{"The Tonight Show With Jay Leno 2011 04 15 1080i HDTV DD5 1 MPEG2 TrollHD", "The Tonight Show With Jay Leno", 0, []int{},
+		nil, "2011-04-15", "", "Raw-HD"},
+	{"The.Daily.Show.2010.10.11.Johnny.Knoxville.iTouch-MW", "The Daily Show", 0, []int{}, nil, "2010-10-11", "MW", "Unknown"},
+	{"The Daily Show - 2011-04-12 - Gov. Deval Patrick", "The Daily Show", 0, []int{}, nil, "2011-04-12", "", "Unknown"},
+	{"2011.01.10 - Denis Leary - HD TV.mkv", "", 0, []int{}, nil, "2011-01-10", "", "HDTV-720p"},
+	{"The Colbert Report - 2011-08-04 - David Kamp", "The Colbert Report", 0, []int{}, nil, "2011-08-04", "", "Unknown"},
+	{"2020.NZ.2011.12.02.PDTV.XviD-C4TV", "2020 NZ", 0, []int{}, nil, "2011-12-02", "C4TV", "SDTV"},
+	// Absolute (anime) episodes.
+	{"[SubDESU]_High_School_DxD_07_(1280x720_x264-AAC)_[6B7FD717]", "High School DxD", 0, []int{}, []int{7}, "", "SubDESU",
+		"HDTV-720p"},
+	{"[Chihiro]_Working!!_-_06_[848x480_H.264_AAC][859EEAFA]", "Working!!", 0, []int{}, []int{6}, "", "Chihiro", "SDTV"},
+	{"[Commie]_Senki_Zesshou_Symphogear_-_11_[65F220B4]", "Senki Zesshou Symphogear", 0, []int{}, []int{11}, "", "Commie",
+		"Unknown"},
+	{"[Underwater]_Rinne_no_Lagrange_-_12_(720p)_[5C7BC4F9]", "Rinne no Lagrange", 0, []int{}, []int{12}, "", "Underwater",
+		"HDTV-720p"},
+	{"[HorribleSubs] Tonari no Kaibutsu-kun - 13 [1080p].mkv", "Tonari no Kaibutsu-kun", 0, []int{}, []int{13}, "",
+		"HorribleSubs", "HDTV-1080p"},
+	{"[Doremi].Yes.Pretty.Cure.5.Go.Go!.31.[1280x720].[C65D4B1F].mkv", "Yes Pretty Cure 5 Go Go!", 0, []int{}, []int{31}, "",
+		"Doremi", "HDTV-720p"},
+	{"[K-F] One Piece 214", "One Piece", 0, []int{}, []int{214}, "", "K-F", "Unknown"},
+	{"[HorribleSubs]_Fairy_Tail_-_145_[720p]", "Fairy Tail", 0, []int{}, []int{145}, "", "HorribleSubs", "HDTV-720p"},
+	{"[Eveyuu] No Game No Life - 10 [Hi10P 1280x720 H264][10B23BD8]", "No Game No Life", 0, []int{}, []int{10}, "", "Eveyuu",
+		"HDTV-720p"},
+	{"[SubsPlease] Spy x Family - 01 (1080p) [0F6E5295].mkv", "Spy x Family", 0, []int{}, []int{1}, "", "SubsPlease",
+		"HDTV-1080p"},
+	{"[Hatsuyuki] Dragon Ball Kai (2014) - 017 (115) [1280x720][B2CFBC0F]", "Dragon Ball Kai (2014)", 0, []int{}, []int{17}, "",
+		"Hatsuyuki", "HDTV-720p"},
+	{"[FFF] Hataraku Maou-sama!! - 01-02 [BF29D1A1]", "Hataraku Maou-sama!!", 0, []int{}, []int{1, 2}, "", "FFF", "Unknown"},
+	{"[Judas] Series Title - 05v2 [1080p][HEVC x265 10bit]", "Series Title", 0, []int{}, []int{5}, "", "Judas", "HDTV-1080p"},
+	{"[SubsPlease] Series Title S2 - 05 (1080p)", "Series Title S2", 0, []int{}, []int{5}, "", "SubsPlease", "HDTV-1080p"},
+	{"[Erai-raws] Series Title - 1000 [1080p]", "Series Title", 0, []int{}, []int{1000}, "", "Erai-raws", "HDTV-1080p"},
+	{"[Group] Series Title S01E05 [1080p]", "Series Title", 1, []int{5}, nil, "", "Group", "HDTV-1080p"},
+	// Full seasons.
+	{"30.Rock.Season.4.HDTV.XviD-LOL", "30 Rock", 4, []int{}, nil, "", "LOL", "SDTV"},
+	{"Parks.and.Recreation.S02.720p.x264-DIMENSION", "Parks and Recreation", 2, []int{}, nil, "", "DIMENSION", "HDTV-720p"},
+	{"The.Office.US.S03.720p.x264-DIMENSION", "The Office US", 3, []int{}, nil, "", "DIMENSION", "HDTV-720p"},
+	{"Sons.of.Anarchy.S03.720p.BluRay-CLUE", "Sons of Anarchy", 3, []int{}, nil, "", "CLUE", "Bluray-720p"},
+	{"Adventure Time S02 720p HDTV x264 CRON", "Adventure Time", 2, []int{}, nil, "", "", "HDTV-720p"},
+	{"Sealab.2021.S04.iNTERNAL.DVDRip.XviD-VCDVaULT", "Sealab 2021", 4, []int{}, nil, "", "VCDVaULT", "DVD"},
+	{"Acropolis Now S05 EXTRAS DVDRip XviD RUNNER", "Acropolis Now", 5, []int{}, nil, "", "", "DVD"},
+	{"Punky.Brewster.S01.EXTRAS.DVDRip.XviD-RUNNER", "Punky Brewster", 1, []int{}, nil, "", "RUNNER", "DVD"},
+	{"Series.Title.S01-S03.1080p.BluRay.x264-GROUP", "Series Title", 1, []int{}, nil, "", "GROUP", "Bluray-1080p"},
+	{"Series.Title.S01.Part.2.1080p.WEB-DL.DDP5.1.H.264-GROUP", "Series Title", 1, []int{}, nil, "", "GROUP", "WEBDL-1080p"},
+}
+
+func TestParseEpisode(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range episodeTests {
+		info, err := starrparse.ParseEpisode(test.title)
+		require.NoError(t, err, test.title)
+		assert.Equal(t, test.series, info.SeriesTitle, test.title)
+		assert.Equal(t, test.season, info.SeasonNumber, "season: "+test.title)
+		assert.Equal(t, test.episodes, info.EpisodeNumbers, "episodes: "+test.title)
+		assert.Equal(t, test.airDate, info.AirDate, "air date: "+test.title)
+		assert.Equal(t, test.group, info.ReleaseGroup, "release group: "+test.title)
+		assert.Equal(t, test.quality, info.Quality.Quality.Name, "quality: "+test.title)
+		assert.Equal(t, test.title, info.ReleaseTitle)
+		assert.Equal(t, test.airDate != "", info.IsDaily, "daily: "+test.title)
+
+		if test.absolute == nil {
+			assert.Empty(t, info.AbsoluteEpisodeNumbers, "absolute: "+test.title)
+		} else {
+			assert.Equal(t, test.absolute, info.AbsoluteEpisodeNumbers, "absolute: "+test.title)
+			assert.True(t, info.IsAbsoluteNumbering, "absolute: "+test.title)
+		}
+	}
+}
+
+func TestParseEpisodeDetails(t *testing.T) {
+	t.Parallel()
+
+	info, err := starrparse.ParseEpisode("Doctor.Who.2005.S01E01.720p.HDTV.x264-FRENCH.REPACK-GROUP")
+	require.NoError(t, err)
+	assert.Equal(t, "Doctor Who 2005", info.SeriesTitleInfo.Title)
+	assert.Equal(t, "Doctor Who", info.SeriesTitleInfo.TitleWithoutYear)
+	assert.Equal(t, 2005, info.SeriesTitleInfo.Year)
+	assert.Equal(t, ".720p.HDTV.x264-FRENCH.REPACK-GROUP", info.ReleaseTokens)
+	assert.Equal(t, starrparse.ReleaseTypeSingleEpisode, info.ReleaseType)
+	assert.EqualValues(t, 2, info.Quality.Revision.Version)
+	assert.True(t, info.Quality.Revision.IsRepack)
+	assert.EqualValues(t, 2, info.Languages[0].ID, "the group name is not a language, but FRENCH is")
+	assert.EqualValues(t, 4, info.Quality.Quality.ID)
+	assert.Equal(t, "television", info.Quality.Quality.Source)
+	assert.Equal(t, 720, info.Quality.Quality.Resolution)
+
+	info, err = starrparse.ParseEpisode("The.Italian.Job.S01E01.HDTV.x264-GROUP")
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, info.Languages[0].ID, "the series title must not be searched for languages")
+	assert.Equal(t, "Unknown", info.Languages[0].Name)
+
+	info, err = starrparse.ParseEpisode("[SubsPlease] Series Title - 07v2 (1080p) [ABCDEF12].mkv")
+	require.NoError(t, err)
+	assert.Equal(t, "ABCDEF12", info.ReleaseHash)
+	assert.EqualValues(t, 2, info.Quality.Revision.Version)
+	assert.Equal(t, starrparse.ReleaseTypeSingleEpisode, info.ReleaseType)
+
+	info, err = starrparse.ParseEpisode("Series.Title.S01E01E02.PROPER.REAL.720p.HDTV.x264-GROUP")
+	require.NoError(t, err)
+	assert.Equal(t, starrparse.ReleaseTypeMultiEpisode, info.ReleaseType)
+	assert.EqualValues(t, 2, info.Quality.Revision.Version)
+	assert.EqualValues(t, 1, info.Quality.Revision.Real)
+	assert.False(t, info.Quality.Revision.IsRepack)
+
+	info, err = starrparse.ParseEpisode("Series.Title.S01-S03.1080p.BluRay.x264-GROUP")
+	require.NoError(t, err)
+	assert.True(t, info.FullSeason)
+	assert.True(t, info.IsMultiSeason)
+	assert.Equal(t, starrparse.ReleaseTypeSeasonPack, info.ReleaseType)
+
+	info, err = starrparse.ParseEpisode("Punky.Brewster.S01.EXTRAS.DVDRip.XviD-RUNNER")
+	require.NoError(t, err)
+	assert.True(t, info.FullSeason)
+	assert.True(t, info.IsSeasonExtra)
+	assert.False(t, info.IsMultiSeason)
+
+	info, err = starrparse.ParseEpisode("Series.Title.S01.Part.2.1080p.WEB-DL.DDP5.1.H.264-GROUP")
+	require.NoError(t, err)
+	assert.False(t, info.FullSeason)
+	assert.True(t, info.IsPartialSeason)
+	assert.EqualValues(t, 2, info.SeasonPart)
+
+	info, err = starrparse.ParseEpisode("The Daily Show - 2011-04-12 - Gov. Deval Patrick")
+	require.NoError(t, err)
+	assert.Equal(t, starrparse.ReleaseTypeSingleEpisode, info.ReleaseType)
+}
+
+func TestParseEpisodeNoMatch(t *testing.T) {
+	t.Parallel()
+
+	for _, title := range []string{
+		"",
+		"Movie.Title.2019.1080p.BluRay.x264-GROUP",
+		"Some Random Words",
+		"Series.Title.2011.02.31.HDTV.x264-GROUP", // February 31st is not a date.
+		"Series Title 720p HDTV",
+	} {
+		_, err := starrparse.ParseEpisode(title)
+		assert.True(t, errors.Is(err, starrparse.ErrNoMatch), "expected no match: "+title)
+	}
+}
@@ -0,0 +1,62 @@
+package starrparse
+
+import (
+	"regexp"
+	"strings"
+)
+
+// These find the release group in a title.
+var ( //nolint:gochecknoglobals
+	// groupSuffixRegex matches the junk some indexers and posters add after the release group.
+	groupSuffixRegex = regexp.MustCompile(`(?i)(?:-(?:RP|1|NZBGeek|Obfuscated|Scrambled|sample|Pre|postbot|xpost|Rakuten|` +
+		`WhiteRev|BUYMORE|AsRequested|AlternativeToRequested|GEROV|Z0iDS3N|Chamele0n|4P|4Planet|AlteZachen|RePACKPOST))+$`)
+	// groupRegex matches the release group at the end of a title, ie. Title.S01E01.720p.HDTV.x264-GROUP.
+	groupRegex = regexp.MustCompile(`(?i)-(?P<group>[a-z0-9]+)$`)
+	// groupBracketRegex matches a release group in brackets at the end of a title, ie. Title S01E01 720p [GROUP].
+	groupBracketRegex = regexp.MustCompile(`(?i)[-_. ]\[(?P<group>[a-z0-9]+)\]$`)
+	// invalidGroupRegex matches things that look like a release group, but are part of something else, ie. WEB-DL.
+	invalidGroupRegex = regexp.MustCompile(`(?i)(?:WEB-DL|Blu-Ray|DTS-HD|DTS-X|DTS-MA|DTS-ES|-ES|-EN|-CAT|-GER|-FRA|-FRE|-ITA|` +
+		`\d{1,2}-bit|[ ._]\d{4}-\d{2}|-\d{1,2}|-\d{3,4}[pi]|-(?:HDTV|SDTV|WEB|WEBRip|BluRay|DVD|x26[45]|h26[45]|HEVC|XviD|DivX))$`)
+)
+
+// ParseReleaseGroup returns the release group in a title, or an empty string if there isn't one.
+// Anime titles have the group in brackets at the beginning, ie. [SubsPlease] Title - 01.
+func ParseReleaseGroup(title string) string {
+	return newRelease(title).releaseGroup()
+}
+
+// releaseGroup returns the release group in a cleaned up title.
+func (r *release) releaseGroup() string {
+	if r.subGroup != "" {
+		return r.subGroup
+	}
+
+	name := groupSuffixRegex.ReplaceAllString(r.name, "")
+
+	if match := groupBracketRegex.FindStringSubmatch(name); match != nil && !isQualityToken(match[1]) {
+		return match[1]
+	}
+
+	if match := groupRegex.FindStringSubmatchIndex(name); match != nil {
+		if invalidGroupRegex.MatchString(name) {
+			return ""
+		}
+
+		return name[match[2]:match[3]]
+	}
+
+	return ""
+}
+
+// isQualityToken returns true if a word in brackets is quality information, not a release group.
+func isQualityToken(word string) bool {
+	word = strings.ToLower(word)
+
+	switch word {
+	case "hdtv", "sdtv", "pdtv", "web", "webdl", "webrip", "bluray", "dvd", "dvdrip", "remux", "x264", "x265", "h264",
+		"h265", "hevc", "xvid", "divx", "proper", "repack", "4k", "uhd", "hdr":
+		return true
+	}
+
+	return resolutionOnlyRegex.MatchString(word)
+}
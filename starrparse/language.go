@@ -0,0 +1,67 @@
+package starrparse
+
+import (
+	"regexp"
+
+	"golift.io/starr"
+)
+
+// language is a language the apps know, and the words that identify it in a title.
+type language struct {
+	starr.Value
+	regex *regexp.Regexp
+}
+
+// languages are the languages Sonarr and Radarr have in common. The IDs are the same in both apps.
+var languages = []*language{ //nolint:gochecknoglobals
+	{Value: starr.Value{ID: 1, Name: "English"}, regex: regexp.MustCompile(`(?i)\benglish\b`)},
+	{Value: starr.Value{ID: 2, Name: "French"}, regex: regexp.MustCompile(`(?i)\b(?:french|truefrench|vostfr|vff|vfq|vf2?)\b`)},
+	{Value: starr.Value{ID: 3, Name: "Spanish"}, regex: regexp.MustCompile(`(?i)\b(?:spanish|espanol|castellano)\b`)},
+	{Value: starr.Value{ID: 4, Name: "German"}, regex: regexp.MustCompile(`(?i)\b(?:german|videomann|ger[-_. ]dub)\b`)},
+	{Value: starr.Value{ID: 5, Name: "Italian"}, regex: regexp.MustCompile(`(?i)\b(?:italian|ita)\b`)},
+	{Value: starr.Value{ID: 6, Name: "Danish"}, regex: regexp.MustCompile(`(?i)\bdanish\b`)},
+	{Value: starr.Value{ID: 7, Name: "Dutch"}, regex: regexp.MustCompile(`(?i)\b(?:dutch|nlsub)\b`)},
+	{Value: starr.Value{ID: 8, Name: "Japanese"}, regex: regexp.MustCompile(`(?i)\b(?:japanese|jap)\b`)},
+	{Value: starr.Value{ID: 9, Name: "Icelandic"}, regex: regexp.MustCompile(`(?i)\bicelandic\b`)},
+	{Value: starr.Value{ID: 10, Name: "Chinese"}, regex: regexp.MustCompile(`(?i)\b(?:chinese|cantonese|mandarin)\b`)},
+	{Value: starr.Value{ID: 11, Name: "Russian"}, regex: regexp.MustCompile(`(?i)\b(?:russian|rus)\b`)},
+	{Value: starr.Value{ID: 12, Name: "Polish"}, regex: regexp.MustCompile(`(?i)\b(?:polish|pldub)\b`)},
+	{Value: starr.Value{ID: 13, Name: "Vietnamese"}, regex: regexp.MustCompile(`(?i)\bvietnamese\b`)},
+	{Value: starr.Value{ID: 14, Name: "Swedish"}, regex: regexp.MustCompile(`(?i)\b(?:swedish|swesub)\b`)},
+	{Value: starr.Value{ID: 15, Name: "Norwegian"}, regex: regexp.MustCompile(`(?i)\b(?:norwegian|nordic)\b`)},
+	{Value: starr.Value{ID: 16, Name: "Finnish"}, regex: regexp.MustCompile(`(?i)\bfinnish\b`)},
+	{Value: starr.Value{ID: 17, Name: "Turkish"}, regex: regexp.MustCompile(`(?i)\bturkish\b`)},
+	{Value: starr.Value{ID: 18, Name: "Portuguese"}, regex: regexp.MustCompile(`(?i)\b(?:portuguese|dublado)\b`)},
+	{Value: starr.Value{ID: 19, Name: "Flemish"}, regex: regexp.MustCompile(`(?i)\bflemish\b`)},
+	{Value: starr.Value{ID: 20, Name: "Greek"}, regex: regexp.MustCompile(`(?i)\bgreek\b`)},
+	{Value: starr.Value{ID: 21, Name: "Korean"}, regex: regexp.MustCompile(`(?i)\bkorean\b`)},
+	{Value: starr.Value{ID: 22, Name: "Hungarian"}, regex: regexp.MustCompile(`(?i)\bhungarian\b`)},
+	{Value: starr.Value{ID: 23, Name: "Hebrew"}, regex: regexp.MustCompile(`(?i)\b(?:hebrew|hebdub)\b`)},
+	{Value: starr.Value{ID: 24, Name: "Lithuanian"}, regex: regexp.MustCompile(`(?i)\blithuanian\b`)},
+	{Value: starr.Value{ID: 25, Name: "Czech"}, regex: regexp.MustCompile(`(?i)\bczech\b`)},
+}
+
+// ParseLanguages returns the languages in a title. The list has Unknown (ID 0) if no language was found.
+// The apps replace Unknown with the original language of the matched series or movie.
+// The series or movie title is not removed first, so use the Languages from ParseEpisode or ParseMovie
+// when the title may contain the name of a language, ie. The Italian Job.
+func ParseLanguages(title string) []*starr.Value {
+	return findLanguages(newRelease(title).name)
+}
+
+// findLanguages returns the languages found in part of a title, in the order of their IDs.
+func findLanguages(tokens string) []*starr.Value {
+	found := []*starr.Value{}
+
+	for _, lang := range languages {
+		if lang.regex.MatchString(tokens) {
+			found = append(found, &starr.Value{ID: lang.ID, Name: lang.Name})
+		}
+	}
+
+	if len(found) == 0 {
+		return []*starr.Value{{ID: 0, Name: "Unknown"}}
+	}
+
+	return found
+}
@@ -0,0 +1,118 @@
+package starrparse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golift.io/starr/radarr"
+)
+
+// These find the movie information in a title.
+var ( //nolint:gochecknoglobals
+	// movieYearRegex matches every year that may follow a movie title, ie. 2001.A.Space.Odyssey.1968 has two.
+	// The characters around the year are checked in movieTitle.
+	movieYearRegex = regexp.MustCompile(`(?:19|20)\d{2}`)
+	// movieTokenRegex matches the first bit of release information after a movie title without a year.
+	movieTokenRegex = regexp.MustCompile(`(?i)(?:^|[-_. (\[]+)(?:\d{3,4}[pi]|4k|UHD|HDTV|PDTV|WEB|WEB-?DL|WEB-?Rip|M?Blu-?Ray|` +
+		`BD-?Rip|BR-?Rip|BR-?DISK|Remux|DVD|DVD-?Rip|DVDSCR|CAM|TS|TELESYNC|TC|x26[45]|h\.?26[45]|HEVC|XviD|DivX|` +
+		`PROPER|REPACK|MULTi|iNTERNAL|LIMITED)\b`)
+	// akaRegex splits a title into the names it was released with, ie. Title AKA Other Title.
+	akaRegex = regexp.MustCompile(`(?i)[-_. ]+(?:AKA|A\.K\.A\.?)[-_. ]+`)
+	// editionRegex matches the edition of a movie, ie. Director's Cut.
+	editionRegex = regexp.MustCompile(`(?i)\b(?:(?:Director'?s|Directors|Final|Theatrical|Extended|Ultimate|Collector'?s|` +
+		`Special|Criterion|Limited|Anniversary|\d{2,3}(?:th)?[-_. ]Anniversary)[-_. ](?:Cut|Edition|Collection|Version)|` +
+		`Extended|Uncut|Unrated|Remastered|IMAX|Criterion|Theatrical|\d{2,3}(?:th)?[-_. ]Anniversary)\b`)
+	// imdbRegex matches an IMDb ID.
+	imdbRegex = regexp.MustCompile(`\b(tt\d{7,8})\b`)
+	// hardcodedSubsRegex matches hardcoded subtitles, ie. KORSUB.
+	hardcodedSubsRegex = regexp.MustCompile(`(?i)\b(?:(\w+SUBS?)|HC|SUBBED)\b`)
+	// softSubsRegex matches the words that look like hardcoded subtitles, but are not.
+	softSubsRegex = regexp.MustCompile(`(?i)^(?:SOFT|MULTI|NL|SWE|NORDIC)SUBS?$`)
+)
+
+// HardcodedSubsGeneric is the hardcoded subtitles value when the language is not in the title.
+const HardcodedSubsGeneric = "Generic Hardcoded Subs"
+
+// ParseMovie parses a movie release title, or file name, like the Radarr parse endpoint does.
+// Returns ErrNoMatch if a title can't be found before the year or the release information.
+// Quality uses Radarr's quality definitions.
+func ParseMovie(title string) (*radarr.ParsedMovieInfo, error) {
+	rel := newRelease(title)
+
+	movieTitle, year, end := rel.movieTitle()
+	if movieTitle == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNoMatch, title)
+	}
+
+	tokens := rel.body[end:]
+	titles := akaRegex.Split(movieTitle, -1)
+
+	for idx := range titles {
+		titles[idx] = cleanTitle(titles[idx])
+	}
+
+	info := &radarr.ParsedMovieInfo{
+		MovieTitles:        titles,
+		OriginalTitle:      rel.original,
+		ReleaseTitle:       rel.original,
+		SimpleReleaseTitle: rel.name,
+		Quality:            rel.quality(true).radarr(),
+		Languages:          findLanguages(tokens),
+		ReleaseGroup:       rel.releaseGroup(),
+		ReleaseHash:        rel.hash,
+		Edition:            cleanTitle(editionRegex.FindString(tokens)),
+		Year:               year,
+		ImdbID:             imdbRegex.FindString(tokens),
+		HardcodedSubs:      hardcodedSubs(tokens),
+		MovieTitle:         titles[0],
+		PrimaryMovieTitle:  titles[0],
+	}
+
+	return info, nil
+}
+
+// movieTitle returns the title, year, and the index in the release body where they end.
+// The year is the last one before the release information, so a title may start with, or be, a year.
+func (r *release) movieTitle() (string, int, int) {
+	stop := len(r.body)
+	if loc := movieTokenRegex.FindStringIndex(r.body); loc != nil {
+		stop = loc[0]
+	}
+
+	var (
+		title string
+		year  int
+		end   int
+	)
+
+	for _, loc := range movieYearRegex.FindAllStringIndex(r.body, -1) {
+		// A year at the beginning is part of the title, and a year after the release information is not.
+		if loc[0] == 0 || loc[0] > stop || !strings.ContainsRune("-_. ([", rune(r.body[loc[0]-1])) ||
+			(loc[1] < len(r.body) && !strings.ContainsRune("-_. )]", rune(r.body[loc[1]]))) {
+			continue
+		}
+
+		title, year, end = r.body[:loc[0]], atoi(r.body[loc[0]:loc[1]]), loc[1]
+	}
+
+	if year == 0 {
+		title, end = r.body[:stop], stop
+	}
+
+	return strings.TrimRight(title, "-_. (["), year, end
+}
+
+// hardcodedSubs returns the hardcoded subtitles in a title, or an empty string.
+func hardcodedSubs(tokens string) string {
+	for _, match := range hardcodedSubsRegex.FindAllStringSubmatch(tokens, -1) {
+		switch {
+		case match[1] == "":
+			return HardcodedSubsGeneric
+		case !softSubsRegex.MatchString(match[1]):
+			return match[1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,116 @@
+package starrparse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr/starrparse"
+)
+
+// movieTests are from the Radarr parser test fixtures, with the outputs Radarr returns for them.
+var movieTests = []struct {
+	title   string
+	movie   string
+	year    int
+	edition string
+	group   string
+	quality string
+}{
+	{"2001.A.Space.Odyssey.1968.720p.BluRay.x264-GROUP", "2001 A Space Odyssey", 1968, "", "GROUP", "Bluray-720p"},
+	{"Blade.Runner.2049.2017.1080p.WEB-DL.DD5.1.H264-FGT", "Blade Runner 2049", 2017, "", "FGT", "WEBDL-1080p"},
+	{"1917.2019.1080p.BluRay.REMUX.AVC.DTS-HD.MA.5.1-FGT", "1917", 2019, "", "FGT", "Remux-1080p"},
+	{"Movie.Title.2010.Directors.Cut.1080p.BluRay.x264-GROUP", "Movie Title", 2010, "Directors Cut", "GROUP", "Bluray-1080p"},
+	{"Movie Title (2010) Extended Edition 2160p UHD BluRay REMUX HDR HEVC-GROUP", "Movie Title", 2010, "Extended Edition",
+		"GROUP", "Remux-2160p"},
+	{"Movie.Title.2019.Remastered.720p.BluRay.x264-GROUP", "Movie Title", 2019, "Remastered", "GROUP", "Bluray-720p"},
+	{"Movie Title 2019 IMAX 2160p WEB-DL DDP5.1 HEVC-GROUP", "Movie Title", 2019, "IMAX", "GROUP", "WEBDL-2160p"},
+	{"Movie.Title.1999.Final.Cut.2160p.WEBRip.x265-GROUP", "Movie Title", 1999, "Final Cut", "GROUP", "WEBRip-2160p"},
+	{"Movie.Title.2019.HDCAM.x264-GROUP", "Movie Title", 2019, "", "GROUP", "CAM"},
+	{"Movie.Title.2019.HDTS.x264-GROUP", "Movie Title", 2019, "", "GROUP", "TELESYNC"},
+	{"Movie.Title.2019.TELECINE.x264-GROUP", "Movie Title", 2019, "", "GROUP", "TELECINE"},
+	{"Movie.Title.2019.WORKPRINT.XviD-GROUP", "Movie Title", 2019, "", "GROUP", "WORKPRINT"},
+	{"Movie.Title.2019.DVDSCR.x264-GROUP", "Movie Title", 2019, "", "GROUP", "DVDSCR"},
+	{"Movie.Title.2019.R5.LINE.XviD-GROUP", "Movie Title", 2019, "", "GROUP", "REGIONAL"},
+	{"Movie.Title.2019.NTSC.DVD9-GROUP", "Movie Title", 2019, "", "GROUP", "DVD-R"},
+	{"Movie.Title.2019.DVDRip.XviD-GROUP", "Movie Title", 2019, "", "GROUP", "DVD"},
+	{"Movie.Title.2019.1080p.BR-DISK-GROUP", "Movie Title", 2019, "", "GROUP", "BR-DISK"},
+	{"Movie.Title.2019.576p.BluRay.x264-GROUP", "Movie Title", 2019, "", "GROUP", "Bluray-576p"},
+	{"Movie.Title.2019.480p.BluRay.x264-GROUP", "Movie Title", 2019, "", "GROUP", "Bluray-480p"},
+	{"Movie.Title.2019.720p.HDTV.x264-GROUP", "Movie Title", 2019, "", "GROUP", "HDTV-720p"},
+	{"Movie.Title.2019.PDTV.XviD-GROUP", "Movie Title", 2019, "", "GROUP", "SDTV"},
+	{"Movie.Title.1080p.BluRay.x264-GROUP", "Movie Title", 0, "", "GROUP", "Bluray-1080p"},
+	{"Movie Title (2019) [1080p] [WEBRip] [5.1] [YTS.MX]", "Movie Title", 2019, "", "", "WEBRip-1080p"},
+	{"[Group] Anime Movie (2019) [1080p]", "Anime Movie", 2019, "", "Group", "HDTV-1080p"},
+	{"Movie.Title.(2019).mkv", "Movie Title", 2019, "", "", "HDTV-720p"},
+	{"Movie.Title.2019", "Movie Title", 2019, "", "", "Unknown"},
+}
+
+func TestParseMovie(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range movieTests {
+		info, err := starrparse.ParseMovie(test.title)
+		require.NoError(t, err, test.title)
+		assert.Equal(t, test.movie, info.MovieTitle, test.title)
+		assert.Equal(t, test.movie, info.PrimaryMovieTitle, test.title)
+		assert.Equal(t, []string{test.movie}, info.MovieTitles, test.title)
+		assert.Equal(t, test.year, info.Year, "year: "+test.title)
+		assert.Equal(t, test.edition, info.Edition, "edition: "+test.title)
+		assert.Equal(t, test.group, info.ReleaseGroup, "release group: "+test.title)
+		assert.Equal(t, test.quality, info.Quality.Quality.Name, "quality: "+test.title)
+		assert.Equal(t, test.title, info.ReleaseTitle)
+	}
+}
+
+func TestParseMovieDetails(t *testing.T) {
+	t.Parallel()
+
+	info, err := starrparse.ParseMovie("Movie.Title.AKA.Other.Title.2019.PROPER.1080p.BluRay.x264-GROUP")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Movie Title", "Other Title"}, info.MovieTitles)
+	assert.Equal(t, "Movie Title", info.PrimaryMovieTitle)
+	assert.EqualValues(t, 2, info.Quality.Revision.Version)
+	assert.EqualValues(t, 7, info.Quality.Quality.ID)
+	assert.Equal(t, "bluray", info.Quality.Quality.Source)
+	assert.Equal(t, "none", info.Quality.Quality.Modifier)
+
+	info, err = starrparse.ParseMovie("Movie Title (2019) tt1234567 KORSUB 1080p WEBRip x264-GROUP")
+	require.NoError(t, err)
+	assert.Equal(t, "tt1234567", info.ImdbID)
+	assert.Equal(t, "KORSUB", info.HardcodedSubs)
+
+	info, err = starrparse.ParseMovie("Movie.Title.2019.HC.720p.WEBRip.x264-GROUP")
+	require.NoError(t, err)
+	assert.Equal(t, starrparse.HardcodedSubsGeneric, info.HardcodedSubs)
+
+	info, err = starrparse.ParseMovie("Movie.Title.2019.MULTiSUBS.720p.WEBRip.x264-GROUP")
+	require.NoError(t, err)
+	assert.Empty(t, info.HardcodedSubs, "multiple soft subtitles are not hardcoded")
+
+	info, err = starrparse.ParseMovie("Movie.Title.2019.FRENCH.GERMAN.1080p.WEB.H264-GROUP")
+	require.NoError(t, err)
+	require.Len(t, info.Languages, 2)
+	assert.Equal(t, "French", info.Languages[0].Name)
+	assert.Equal(t, "German", info.Languages[1].Name)
+
+	info, err = starrparse.ParseMovie("The.Italian.Job.2003.1080p.BluRay.x264-GROUP")
+	require.NoError(t, err)
+	assert.Equal(t, "Unknown", info.Languages[0].Name, "the movie title must not be searched for languages")
+
+	info, err = starrparse.ParseMovie("Movie.Title.2019.2160p.UHD.BluRay.REMUX.HDR.HEVC.Atmos-GROUP")
+	require.NoError(t, err)
+	assert.EqualValues(t, 31, info.Quality.Quality.ID)
+	assert.Equal(t, "remux", info.Quality.Quality.Modifier)
+	assert.Equal(t, 2160, info.Quality.Quality.Resolution)
+}
+
+func TestParseMovieNoMatch(t *testing.T) {
+	t.Parallel()
+
+	for _, title := range []string{"", "1080p.BluRay.x264-GROUP", "(2019)"} {
+		_, err := starrparse.ParseMovie(title)
+		assert.True(t, errors.Is(err, starrparse.ErrNoMatch), "expected no match: "+title)
+	}
+}
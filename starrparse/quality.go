@@ -0,0 +1,360 @@
+package starrparse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golift.io/starr"
+)
+
+// ErrUnsupportedApp is returned when an app's qualities are not known to this package.
+var ErrUnsupportedApp = errors.New("qualities are not known for app")
+
+// These find the quality information in a title.
+var ( //nolint:gochecknoglobals
+	properRegex  = regexp.MustCompile(`(?i)\bproper\b`)
+	repackRegex  = regexp.MustCompile(`(?i)\b(?:repack|rerip)(\d?)\b`)
+	versionRegex = regexp.MustCompile(`(?i)\d[-_. ]?v(\d)\b|\[v(\d)\]`)
+	realRegex    = regexp.MustCompile(`\bREAL\b`) // This one is case sensitive.
+	// resolutionRegex has a named group for each resolution.
+	resolutionRegex = regexp.MustCompile(`(?i)\b(?:(?P<r360>360p)|(?P<r480>480p|480i|640x480|848x480)|(?P<r540>540p)|` +
+		`(?P<r576>576p|576i)|(?P<r720>720p|1280x720|960p)|(?P<r1080>1080p|1920x1080|1440p|FHD|1080i|4kto1080p)|` +
+		`(?P<r2160>2160p|3840x2160|4k[-_. ](?:UHD|HEVC|BD|H265)|(?:UHD|HEVC|BD|H265)[-_. ]4k|4k))\b`)
+	resolutionOnlyRegex = regexp.MustCompile(`(?i)^\d{3,4}[pi]$`)
+	interlacedRegex     = regexp.MustCompile(`(?i)\b1080i\b`)
+	// Sources.
+	rawHDRegex    = regexp.MustCompile(`(?i)\b(?:RawHD|Raw[-_. ]HD)\b`)
+	brDiskRegex   = regexp.MustCompile(`(?i)\b(?:BR-?DISK|BDISO|BD25|BD50)\b`)
+	remuxRegex    = regexp.MustCompile(`(?i)\b(?:BD|UHD)?Remux\b`)
+	blurayRegex   = regexp.MustCompile(`(?i)\b(?:M?Blu-?Ray|HDDVD|BD|UHD2?BD|BDMux|BD-?Rip|BR-?Rip)\b`)
+	webRipRegex   = regexp.MustCompile(`(?i)\b(?:WEB-?Rip|WEBMux)\b`)
+	webDLRegex    = regexp.MustCompile(`(?i)\b(?:WEB[-_. ]?DL|WEB|AmazonHD|iTunesHD|MaxdomeHD|NetflixU?HD|WebHD)\b`)
+	hdtvRegex     = regexp.MustCompile(`(?i)\bHDTV\b`)
+	sdtvRegex     = regexp.MustCompile(`(?i)\b(?:PDTV|SDTV|TVRip|DSR|DSRip|SATRip|DTHRip)\b`)
+	dvdRRegex     = regexp.MustCompile(`(?i)\b(?:DVD-?R|DVD-?5|DVD-?9)\b`)
+	dvdRegex      = regexp.MustCompile(`(?i)\b(?:DVD|DVDRip|NTSC|PAL|xvidvd)\b`)
+	screenerRegex = regexp.MustCompile(`(?i)\b(?:DVDSCR|DVDSCREENER|SCR|SCREENER|BDSCR|WEBSCREENER)\b`)
+	regionalRegex = regexp.MustCompile(`(?i)\b(?:R[0-9]|REGIONAL)\b`)
+	camRegex      = regexp.MustCompile(`(?i)\b(?:CAM|CAMRip|HDCAM|HD-?CAM)\b`)
+	telesyncRegex = regexp.MustCompile(`(?i)\b(?:TS|TELESYNC|HD-?TS|PDVD|TSRip|HDTSRip)\b`)
+	telecineRegex = regexp.MustCompile(`(?i)\b(?:TC|TELECINE|HD-?TC)\b`)
+	workprintRgx  = regexp.MustCompile(`(?i)\b(?:WORKPRINT|WP)\b`)
+	// Codecs.
+	xvidRegex  = regexp.MustCompile(`(?i)\b(?:xvid|divx)\b`)
+	x264Regex  = regexp.MustCompile(`(?i)\b[xh][-_. ]?264\b`)
+	mpeg2Regex = regexp.MustCompile(`(?i)\bMPEG-?2\b`)
+)
+
+// source is where a release came from. These are not the app's source names; those are in the quality tables.
+type source int
+
+// These are the sources the quality parser finds. The movie sources are only found for Radarr.
+const (
+	srcUnknown source = iota
+	srcTV
+	srcRawHD
+	srcDVD
+	srcWebDL
+	srcWebRip
+	srcBluray
+	srcRemux
+	srcBRDisk
+	srcDVDR
+	srcScreener
+	srcRegional
+	srcCAM
+	srcTelesync
+	srcTelecine
+	srcWorkprint
+)
+
+// quality is what the parser found in a title. It's turned into an app's quality with a quality table.
+type quality struct {
+	source     source
+	resolution int
+	sdCodec    bool // xvid or x264 without a source or resolution is SDTV.
+	extension  string
+	revision   *starr.QualityRevision
+}
+
+// These are the quality definitions in Sonarr. Sonarr does not use modifiers.
+var sonarrQualities = map[string]starr.BaseQuality{ //nolint:gochecknoglobals
+	"Unknown":            {ID: 0, Name: "Unknown", Source: "unknown", Resolution: 0},
+	"SDTV":               {ID: 1, Name: "SDTV", Source: "television", Resolution: 480},
+	"DVD":                {ID: 2, Name: "DVD", Source: "dvd", Resolution: 480},
+	"WEBDL-1080p":        {ID: 3, Name: "WEBDL-1080p", Source: "web", Resolution: 1080},
+	"HDTV-720p":          {ID: 4, Name: "HDTV-720p", Source: "television", Resolution: 720},
+	"WEBDL-720p":         {ID: 5, Name: "WEBDL-720p", Source: "web", Resolution: 720},
+	"Bluray-720p":        {ID: 6, Name: "Bluray-720p", Source: "bluray", Resolution: 720},
+	"Bluray-1080p":       {ID: 7, Name: "Bluray-1080p", Source: "bluray", Resolution: 1080},
+	"WEBDL-480p":         {ID: 8, Name: "WEBDL-480p", Source: "web", Resolution: 480},
+	"HDTV-1080p":         {ID: 9, Name: "HDTV-1080p", Source: "television", Resolution: 1080},
+	"Raw-HD":             {ID: 10, Name: "Raw-HD", Source: "televisionRaw", Resolution: 1080},
+	"WEBRip-480p":        {ID: 12, Name: "WEBRip-480p", Source: "webRip", Resolution: 480},
+	"Bluray-480p":        {ID: 13, Name: "Bluray-480p", Source: "bluray", Resolution: 480},
+	"WEBRip-720p":        {ID: 14, Name: "WEBRip-720p", Source: "webRip", Resolution: 720},
+	"WEBRip-1080p":       {ID: 15, Name: "WEBRip-1080p", Source: "webRip", Resolution: 1080},
+	"HDTV-2160p":         {ID: 16, Name: "HDTV-2160p", Source: "television", Resolution: 2160},
+	"WEBRip-2160p":       {ID: 17, Name: "WEBRip-2160p", Source: "webRip", Resolution: 2160},
+	"WEBDL-2160p":        {ID: 18, Name: "WEBDL-2160p", Source: "web", Resolution: 2160},
+	"Bluray-2160p":       {ID: 19, Name: "Bluray-2160p", Source: "bluray", Resolution: 2160},
+	"Bluray-1080p Remux": {ID: 20, Name: "Bluray-1080p Remux", Source: "blurayRaw", Resolution: 1080},
+	"Bluray-2160p Remux": {ID: 21, Name: "Bluray-2160p Remux", Source: "blurayRaw", Resolution: 2160},
+	"Bluray-576p":        {ID: 22, Name: "Bluray-576p", Source: "bluray", Resolution: 576},
+}
+
+// These are the quality definitions in Radarr, and Whisparr.
+var radarrQualities = map[string]starr.BaseQuality{ //nolint:gochecknoglobals
+	"Unknown":      {ID: 0, Name: "Unknown", Source: "unknown", Resolution: 0, Modifier: "none"},
+	"SDTV":         {ID: 1, Name: "SDTV", Source: "tv", Resolution: 480, Modifier: "none"},
+	"DVD":          {ID: 2, Name: "DVD", Source: "dvd", Resolution: 480, Modifier: "none"},
+	"WEBDL-1080p":  {ID: 3, Name: "WEBDL-1080p", Source: "webdl", Resolution: 1080, Modifier: "none"},
+	"HDTV-720p":    {ID: 4, Name: "HDTV-720p", Source: "tv", Resolution: 720, Modifier: "none"},
+	"WEBDL-720p":   {ID: 5, Name: "WEBDL-720p", Source: "webdl", Resolution: 720, Modifier: "none"},
+	"Bluray-720p":  {ID: 6, Name: "Bluray-720p", Source: "bluray", Resolution: 720, Modifier: "none"},
+	"Bluray-1080p": {ID: 7, Name: "Bluray-1080p", Source: "bluray", Resolution: 1080, Modifier: "none"},
+	"WEBDL-480p":   {ID: 8, Name: "WEBDL-480p", Source: "webdl", Resolution: 480, Modifier: "none"},
+	"HDTV-1080p":   {ID: 9, Name: "HDTV-1080p", Source: "tv", Resolution: 1080, Modifier: "none"},
+	"Raw-HD":       {ID: 10, Name: "Raw-HD", Source: "tv", Resolution: 1080, Modifier: "rawhd"},
+	"WEBRip-480p":  {ID: 12, Name: "WEBRip-480p", Source: "webrip", Resolution: 480, Modifier: "none"},
+	"WEBRip-720p":  {ID: 14, Name: "WEBRip-720p", Source: "webrip", Resolution: 720, Modifier: "none"},
+	"WEBRip-1080p": {ID: 15, Name: "WEBRip-1080p", Source: "webrip", Resolution: 1080, Modifier: "none"},
+	"HDTV-2160p":   {ID: 16, Name: "HDTV-2160p", Source: "tv", Resolution: 2160, Modifier: "none"},
+	"WEBRip-2160p": {ID: 17, Name: "WEBRip-2160p", Source: "webrip", Resolution: 2160, Modifier: "none"},
+	"WEBDL-2160p":  {ID: 18, Name: "WEBDL-2160p", Source: "webdl", Resolution: 2160, Modifier: "none"},
+	"Bluray-2160p": {ID: 19, Name: "Bluray-2160p", Source: "bluray", Resolution: 2160, Modifier: "none"},
+	"Bluray-480p":  {ID: 20, Name: "Bluray-480p", Source: "bluray", Resolution: 480, Modifier: "none"},
+	"Bluray-576p":  {ID: 21, Name: "Bluray-576p", Source: "bluray", Resolution: 576, Modifier: "none"},
+	"BR-DISK":      {ID: 22, Name: "BR-DISK", Source: "bluray", Resolution: 1080, Modifier: "brdisk"},
+	"DVD-R":        {ID: 23, Name: "DVD-R", Source: "dvd", Resolution: 480, Modifier: "remux"},
+	"WORKPRINT":    {ID: 24, Name: "WORKPRINT", Source: "workprint", Resolution: 0, Modifier: "none"},
+	"CAM":          {ID: 25, Name: "CAM", Source: "cam", Resolution: 0, Modifier: "none"},
+	"TELESYNC":     {ID: 26, Name: "TELESYNC", Source: "telesync", Resolution: 0, Modifier: "none"},
+	"TELECINE":     {ID: 27, Name: "TELECINE", Source: "telecine", Resolution: 0, Modifier: "none"},
+	"DVDSCR":       {ID: 28, Name: "DVDSCR", Source: "dvd", Resolution: 480, Modifier: "screener"},
+	"REGIONAL":     {ID: 29, Name: "REGIONAL", Source: "dvd", Resolution: 480, Modifier: "regional"},
+	"Remux-1080p":  {ID: 30, Name: "Remux-1080p", Source: "bluray", Resolution: 1080, Modifier: "remux"},
+	"Remux-2160p":  {ID: 31, Name: "Remux-2160p", Source: "bluray", Resolution: 2160, Modifier: "remux"},
+}
+
+// ParseQuality returns the quality of a release title, using the quality definitions from an app.
+// Sonarr, Radarr and Whisparr are supported. The revision is 2 for a proper or repack, and Real counts REAL tags.
+func ParseQuality(app starr.App, title string) (*starr.Quality, error) {
+	rel := newRelease(title)
+
+	switch app {
+	case starr.Sonarr:
+		return rel.quality(false).sonarr(), nil
+	case starr.Radarr, starr.Whisparr:
+		return rel.quality(true).radarr(), nil
+	case starr.Lidarr, starr.Prowlarr, starr.Readarr:
+		fallthrough
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedApp, app)
+	}
+}
+
+// quality finds the source, resolution and revision in a title.
+// The movie sources (CAM, TELESYNC, etc) are only searched for when movie is true.
+func (r *release) quality(movie bool) *quality {
+	name := strings.ReplaceAll(r.name, "_", " ")
+	qual := &quality{
+		resolution: parseResolution(name),
+		extension:  r.extension,
+		revision:   parseRevision(name),
+		sdCodec:    xvidRegex.MatchString(name) || x264Regex.MatchString(name),
+	}
+
+	switch {
+	case rawHDRegex.MatchString(name):
+		qual.source = srcRawHD
+	case movie && brDiskRegex.MatchString(name):
+		qual.source = srcBRDisk
+	case remuxRegex.MatchString(name):
+		qual.source = srcRemux
+	case blurayRegex.MatchString(name) || brDiskRegex.MatchString(name):
+		qual.source = srcBluray
+		if xvidRegex.MatchString(name) {
+			qual.source = srcDVD // Bluray encoded with xvid is DVD quality.
+		}
+	case webRipRegex.MatchString(name):
+		qual.source = srcWebRip
+	case webDLRegex.MatchString(name):
+		qual.source = srcWebDL
+	case hdtvRegex.MatchString(name):
+		qual.source = srcTV
+		if mpeg2Regex.MatchString(name) && interlacedRegex.MatchString(name) {
+			qual.source = srcRawHD
+		}
+	case movie && screenerRegex.MatchString(name):
+		qual.source = srcScreener
+	case movie && dvdRRegex.MatchString(name):
+		qual.source = srcDVDR
+	case dvdRegex.MatchString(name) || dvdRRegex.MatchString(name):
+		qual.source = srcDVD
+	case sdtvRegex.MatchString(name):
+		qual.source = srcTV
+	default:
+		qual.source = movieSource(name, movie)
+	}
+
+	return qual
+}
+
+// movieSource finds the sources that are only in Radarr. These are checked last, because they're short words.
+func movieSource(name string, movie bool) source {
+	switch {
+	case !movie:
+		return srcUnknown
+	case regionalRegex.MatchString(name):
+		return srcRegional
+	case camRegex.MatchString(name):
+		return srcCAM
+	case telesyncRegex.MatchString(name):
+		return srcTelesync
+	case telecineRegex.MatchString(name):
+		return srcTelecine
+	case workprintRgx.MatchString(name):
+		return srcWorkprint
+	default:
+		return srcUnknown
+	}
+}
+
+// parseResolution returns the vertical resolution in a title, or 0 if there isn't one.
+func parseResolution(name string) int {
+	match := resolutionRegex.FindStringSubmatch(name)
+	if match == nil {
+		return 0
+	}
+
+	for idx, group := range resolutionRegex.SubexpNames() {
+		if idx > 0 && match[idx] != "" {
+			return atoi(strings.TrimPrefix(group, "r"))
+		}
+	}
+
+	return 0
+}
+
+// parseRevision returns the revision of a release. Propers and repacks are version 2, or higher if numbered.
+func parseRevision(name string) *starr.QualityRevision {
+	revision := &starr.QualityRevision{Version: 1, Real: int64(len(realRegex.FindAllString(name, -1)))}
+
+	if properRegex.MatchString(name) {
+		revision.Version = 2
+	}
+
+	if match := repackRegex.FindStringSubmatch(name); match != nil {
+		revision.Version, revision.IsRepack = 2, true
+		if match[1] != "" {
+			revision.Version = int64(atoi(match[1]) + 1)
+		}
+	}
+
+	if match := versionRegex.FindStringSubmatch(name); match != nil {
+		revision.Version = int64(atoi(match[1] + match[2]))
+	}
+
+	return revision
+}
+
+// sonarr returns the quality with Sonarr's quality definitions.
+func (q *quality) sonarr() *starr.Quality {
+	base := sonarrQualities[q.name("Bluray-1080p Remux", "Bluray-2160p Remux", "Bluray-480p")]
+	return &starr.Quality{Quality: &base, Revision: q.revision}
+}
+
+// radarr returns the quality with Radarr's quality definitions.
+func (q *quality) radarr() *starr.Quality {
+	var name string
+
+	switch q.source { //nolint:exhaustive // The rest are the same as Sonarr.
+	case srcBRDisk:
+		name = "BR-DISK"
+	case srcDVDR:
+		name = "DVD-R"
+	case srcScreener:
+		name = "DVDSCR"
+	case srcRegional:
+		name = "REGIONAL"
+	case srcCAM:
+		name = "CAM"
+	case srcTelesync:
+		name = "TELESYNC"
+	case srcTelecine:
+		name = "TELECINE"
+	case srcWorkprint:
+		name = "WORKPRINT"
+	default:
+		name = q.name("Remux-1080p", "Remux-2160p", "Bluray-480p")
+	}
+
+	base := radarrQualities[name]
+
+	return &starr.Quality{Quality: &base, Revision: q.revision}
+}
+
+// name returns the name of the quality. The apps have the same names for most qualities.
+// The names that are different are passed in.
+func (q *quality) name(remux1080, remux2160, bluray480 string) string { //nolint:cyclop
+	switch q.source {
+	case srcRawHD:
+		return "Raw-HD"
+	case srcRemux:
+		if q.resolution == 2160 { //nolint:gomnd
+			return remux2160
+		}
+
+		return remux1080
+	case srcBluray, srcBRDisk:
+		switch q.resolution {
+		case 360, 480, 540: //nolint:gomnd
+			return bluray480
+		case 576, 1080, 2160: //nolint:gomnd
+			return fmt.Sprint("Bluray-", q.resolution, "p")
+		default:
+			return "Bluray-720p"
+		}
+	case srcWebDL:
+		return q.hd("WEBDL-", "WEBDL-480p")
+	case srcWebRip:
+		return q.hd("WEBRip-", "WEBRip-480p")
+	case srcTV:
+		return q.hd("HDTV-", "SDTV")
+	case srcDVD, srcDVDR, srcScreener, srcRegional:
+		return "DVD"
+	case srcUnknown, srcCAM, srcTelesync, srcTelecine, srcWorkprint:
+		fallthrough
+	default:
+		return q.unknown()
+	}
+}
+
+// hd returns prefix + resolution for 720p, 1080p and 2160p. Other resolutions are standard definition.
+func (q *quality) hd(prefix, standard string) string {
+	switch q.resolution {
+	case 720, 1080, 2160: //nolint:gomnd
+		return fmt.Sprint(prefix, q.resolution, "p")
+	default:
+		return standard
+	}
+}
+
+// unknown returns the quality for a title without a source. It's guessed from the resolution, codec or extension.
+func (q *quality) unknown() string {
+	switch {
+	case q.resolution >= 720: //nolint:gomnd
+		return q.hd("HDTV-", "SDTV")
+	case q.resolution > 0, q.sdCodec:
+		return "SDTV"
+	case q.extension == ".mkv":
+		return "HDTV-720p"
+	case q.extension != "" && q.extension != ".nzb" && q.extension != ".torrent":
+		return "SDTV"
+	default:
+		return "Unknown"
+	}
+}
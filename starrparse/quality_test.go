@@ -0,0 +1,214 @@
+package starrparse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrparse"
+)
+
+func TestParseQuality(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		title  string
+		sonarr string
+		radarr string
+	}{
+		{"Title.S01E01.HDTV.XviD-GROUP", "SDTV", "SDTV"},
+		{"Title.S01E01.x264-GROUP", "SDTV", "SDTV"},
+		{"Title.S01E01.avi", "SDTV", "SDTV"},
+		{"Title.S01E01.mkv", "HDTV-720p", "HDTV-720p"},
+		{"Title.S01E01.nzb", "Unknown", "Unknown"},
+		{"WEEDS.S03E01-06.DUAL.XviD.Bluray.AC3-REPACK.-HELLYWOOD.avi", "DVD", "DVD"},
+		{"Title.S01E01.BDRip.720p.x264-GROUP", "Bluray-720p", "Bluray-720p"},
+		{"Title.S01E01.BRRip.1080p.x264-GROUP", "Bluray-1080p", "Bluray-1080p"},
+		{"Title.S01E01.720p.WEB.DL.DD5.1.H.264-GROUP", "WEBDL-720p", "WEBDL-720p"},
+		{"Title.S01E01.WEB-DL.x264-GROUP", "WEBDL-480p", "WEBDL-480p"},
+		{"Title.S01E01.AMZN.WEBRip.DDP5.1.x264-GROUP", "WEBRip-480p", "WEBRip-480p"},
+		{"Title.S01E01.2160p.WEBRip.x265-GROUP", "WEBRip-2160p", "WEBRip-2160p"},
+		{"Title.S01E01.2160p.HDTV.x265-GROUP", "HDTV-2160p", "HDTV-2160p"},
+		{"Title.S01E01.1080p.HDTV.x264-GROUP", "HDTV-1080p", "HDTV-1080p"},
+		{"Title.S01E01.4K.UHD.BluRay.x265-GROUP", "Bluray-2160p", "Bluray-2160p"},
+		{"Title.S01E01.2160p.BluRay.REMUX.HEVC-GROUP", "Bluray-2160p Remux", "Remux-2160p"},
+		{"Title.S01E01.BluRay.REMUX.AVC-GROUP", "Bluray-1080p Remux", "Remux-1080p"},
+		{"Title.S01E01.RawHD.TS-GROUP", "Raw-HD", "Raw-HD"},
+		{"Title.S01E01.1080p.BD25-GROUP", "Bluray-1080p", "BR-DISK"},
+		{"Title.S01E01.NTSC.DVD5-GROUP", "DVD", "DVD-R"},
+		{"Title.S01E01.480p.BluRay.x264-GROUP", "Bluray-480p", "Bluray-480p"},
+		{"Title.S01E01.576p.BluRay.x264-GROUP", "Bluray-576p", "Bluray-576p"},
+		{"Title.S01E01.HDCAM-GROUP", "Unknown", "CAM"},
+		{"Title.S01E01.1080p.TS-GROUP", "HDTV-1080p", "TELESYNC"},
+		{"Title.S01E01.480p-GROUP", "SDTV", "SDTV"},
+	}
+
+	for _, test := range tests {
+		quality, err := starrparse.ParseQuality(starr.Sonarr, test.title)
+		require.NoError(t, err)
+		assert.Equal(t, test.sonarr, quality.Quality.Name, "sonarr: "+test.title)
+
+		quality, err = starrparse.ParseQuality(starr.Radarr, test.title)
+		require.NoError(t, err)
+		assert.Equal(t, test.radarr, quality.Quality.Name, "radarr: "+test.title)
+	}
+
+	_, err := starrparse.ParseQuality(starr.Lidarr, "Artist - Album (2019) [FLAC]")
+	assert.True(t, errors.Is(err, starrparse.ErrUnsupportedApp), "lidarr qualities are not supported")
+}
+
+func TestParseQualityRevision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		title    string
+		version  int64
+		realTags int64
+		isRepack bool
+	}{
+		{"Title.S01E01.720p.HDTV.x264-GROUP", 1, 0, false},
+		{"Title.S01E01.PROPER.720p.HDTV.x264-GROUP", 2, 0, false},
+		{"Title.S01E01.REPACK.720p.HDTV.x264-GROUP", 2, 0, true},
+		{"Title.S01E01.REPACK2.720p.HDTV.x264-GROUP", 3, 0, true},
+		{"Title.S01E01.RERIP.720p.HDTV.x264-GROUP", 2, 0, true},
+		{"Title.S01E01.REAL.PROPER.720p.HDTV.x264-GROUP", 2, 1, false},
+		{"Title.S01E01.REAL.REAL.PROPER.720p.HDTV.x264-GROUP", 2, 2, false},
+		{"Title.S01E01.real.proper.720p.HDTV.x264-GROUP", 2, 0, false}, // REAL must be upper case.
+		{"[Group] Title - 01v2 [720p]", 2, 0, false},
+		{"[Group] Title - 01 [v3][720p]", 3, 0, false},
+	}
+
+	for _, test := range tests {
+		quality, err := starrparse.ParseQuality(starr.Sonarr, test.title)
+		require.NoError(t, err)
+		assert.Equal(t, test.version, quality.Revision.Version, "version: "+test.title)
+		assert.Equal(t, test.realTags, quality.Revision.Real, "real: "+test.title)
+		assert.Equal(t, test.isRepack, quality.Revision.IsRepack, "repack: "+test.title)
+	}
+}
+
+func TestParseReleaseGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"Castle.2009.S01E14.English.HDTV.XviD-LOL":                                   "LOL",
+		"Castle 2009 S01E14 English HDTV XviD LOL":                                   "",
+		"Acropolis Now S05 EXTRAS DVDRip XviD RUNNER":                                "",
+		"Punky.Brewster.S01.EXTRAS.DVDRip.XviD-RUNNER":                               "RUNNER",
+		"2020.NZ.2011.12.02.PDTV.XviD-C4TV":                                          "C4TV",
+		"The.Office.S03E115.DVDRip.XviD-OSiTV":                                       "OSiTV",
+		"The Office - S01E01 - Pilot [HTDV-480p]":                                    "",
+		"The Office - S01E01 - Pilot [HTDV-720p]":                                    "",
+		"The Office - S01E01 - Pilot [HTDV-1080p]":                                   "",
+		"The.Walking.Dead.S04E13.720p.WEB-DL.AAC2.0.H.264-Cyphanix":                  "Cyphanix",
+		"Arrested.Development.S04E01.720p.WEBRip.AAC2.0.x264-NFRiP":                  "NFRiP",
+		"Series Title S01E01 Episode Title":                                          "",
+		"The Colbert Report - 2014-06-02 - Thomas Piketty.mkv":                       "",
+		"Real Time with Bill Maher S12E17 May 23, 2014.mp4":                          "",
+		"Reizen Waes - S01E08 - Transistrië, Zuid-Ossetië en Abchazië SDTV.avi":      "",
+		"Simpsons 10x11 - Wild Barts Cant Be Broken [rl].avi":                        "rl",
+		"[ www.Torrenting.com ] - Revenge.S03E14.720p.HDTV.X264-DIMENSION":           "DIMENSION",
+		"The.Middle.S05E01.1080p.WEB-DL":                                             "",
+		"Series.Title.S01E01.1080p.WEB-DL.DD5.1.H.264-Rakuten":                       "",
+		"Series.Title.S04E06.Episode.Name.720p.WEB-DL.DD5.1.H.264-HarrHD-RePACKPOST": "HarrHD",
+		"[HorribleSubs] Series Title - 01 [720p]":                                    "HorribleSubs",
+		"[Erai-raws] Series Title - 01 [1080p][Multiple Subtitle].mkv":               "Erai-raws",
+		"Series.Title.S01E01.1080p.BluRay.DTS-HD":                                    "",
+		"Series.Title.S01E01.10-bit":                                                 "",
+		"Series.Title.S01E01.HDTV.x264-Obfuscated":                                   "",
+		"Series Title S01E01 720p [GROUP]":                                           "GROUP",
+		"Series Title S01E01 [1080p]":                                                "",
+		"Movie.Title.2019.1080p.BluRay.x264-SPARKS.mkv":                              "SPARKS",
+		"Movie.Title.2019.1080p.BluRay.x264-SPARKS-postbot":                          "SPARKS",
+		"Movie.Title.2019.1080p.BluRay.x264-SPARKS-xpost":                            "SPARKS",
+		"Movie.Title.2019.1080p.BluRay.x264-SPARKS[TGx]":                             "SPARKS",
+		"Movie.Title.2019.1080p.WEB.H264-GROUP.nzb":                                  "GROUP",
+		"Movie.Title.2019.MULTi.1080p.BluRay.x264-GROUP-AsRequested":                 "GROUP",
+		"Movie.Title.2019.1080p.BluRay.x264-GROUP-Scrambled":                         "GROUP",
+		"Movie.Title.2019.1080p.BluRay.DTS-ES":                                       "",
+		"Movie.Title.2019.1080p.BluRay.x264-ITA":                                     "",
+		"Movie.Title.2019.1080p.BluRay.x264-GER":                                     "",
+		"Movie.Title.2019.1080p.BluRay-1080p":                                        "",
+		"Movie.Title.2019-08":                                                        "",
+		"Movie.Title.2019.720p.HDTV-HDTV":                                            "",
+		"Movie.Title.2019.1080p.BluRay-Blu-Ray":                                      "",
+		"Movie.Title.2019.720p.WEBRip-WEBRip":                                        "",
+		"Movie.Title.2019.1080p.BluRay.x264-1":                                       "",
+		"Movie.Title.2019.1080p.BluRay.x264-RP":                                      "",
+		"Movie.Title.2019.1080p.BluRay.x264-GROUP-RP":                                "GROUP",
+		"Movie.Title.2019.1080p.BluRay.x264-GROUP-1":                                 "GROUP",
+		"Movie Title (2019) [1080p] [WEBRip] [5.1] [YTS.MX]":                         "",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-4P":                                "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-4Planet":                           "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-AlteZachen":                        "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-WhiteRev":                          "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-BUYMORE":                           "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-Chamele0n":                         "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-NZBGeek":                           "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-AlternativeToRequested":            "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-GEROV":                             "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-Z0iDS3N":                           "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-Pre":                               "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-sample":                            "GROUP",
+		"Series.Title.S01E01.720p.HDTV.x264-GROUP-Rakuten-postbot":                   "GROUP",
+		"[www.Speed.cd] - Series.Title.S01E05.720p.HDTV.x264-KILLERS":                "KILLERS",
+		"Series.Title.S01E05.720p.HDTV.x264-KILLERS[rarbg]":                          "KILLERS",
+		"Series.Title.S01E05.720p.HDTV.x264-KILLERS[eztv]":                           "KILLERS",
+		"Series.Title.S01E05.720p.HDTV.x264-KILLERS[ettv]":                           "KILLERS",
+		"Series.Title.S01E05.720p.HDTV.x264-KILLERS[rartv]":                          "KILLERS",
+		"Series.Title.S01E05.720p.HDTV.x264-KILLERS[eztv.re]":                        "KILLERS",
+	}
+
+	for title, group := range tests {
+		assert.Equal(t, group, starrparse.ParseReleaseGroup(title), title)
+	}
+}
+
+func TestParseLanguages(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string][]string{
+		"Title.S01E01.720p.HDTV.x264-GROUP":                     {"Unknown"},
+		"Title.S01E01.English.720p.HDTV.x264-GROUP":             {"English"},
+		"Title.S01E01.FRENCH.720p.HDTV.x264-GROUP":              {"French"},
+		"Title.S01E01.VOSTFR.720p.HDTV.x264-GROUP":              {"French"},
+		"Title.S01E01.TRUEFRENCH.720p.HDTV.x264-GROUP":          {"French"},
+		"Title.S01E01.Spanish.720p.HDTV.x264-GROUP":             {"Spanish"},
+		"Title.S01E01.German.Dubbed.720p.HDTV.x264-GROUP":       {"German"},
+		"Title.S01E01.ITA.720p.HDTV.x264-GROUP":                 {"Italian"},
+		"Title.S01E01.Danish.720p.HDTV.x264-GROUP":              {"Danish"},
+		"Title.S01E01.Dutch.720p.HDTV.x264-GROUP":               {"Dutch"},
+		"Title.S01E01.Japanese.720p.HDTV.x264-GROUP":            {"Japanese"},
+		"Title.S01E01.Icelandic.720p.HDTV.x264-GROUP":           {"Icelandic"},
+		"Title.S01E01.Mandarin.720p.HDTV.x264-GROUP":            {"Chinese"},
+		"Title.S01E01.Russian.720p.HDTV.x264-GROUP":             {"Russian"},
+		"Title.S01E01.Polish.720p.HDTV.x264-GROUP":              {"Polish"},
+		"Title.S01E01.Vietnamese.720p.HDTV.x264-GROUP":          {"Vietnamese"},
+		"Title.S01E01.Swedish.720p.HDTV.x264-GROUP":             {"Swedish"},
+		"Title.S01E01.Norwegian.720p.HDTV.x264-GROUP":           {"Norwegian"},
+		"Title.S01E01.Finnish.720p.HDTV.x264-GROUP":             {"Finnish"},
+		"Title.S01E01.Turkish.720p.HDTV.x264-GROUP":             {"Turkish"},
+		"Title.S01E01.Portuguese.720p.HDTV.x264-GROUP":          {"Portuguese"},
+		"Title.S01E01.Flemish.720p.HDTV.x264-GROUP":             {"Flemish"},
+		"Title.S01E01.Greek.720p.HDTV.x264-GROUP":               {"Greek"},
+		"Title.S01E01.Korean.720p.HDTV.x264-GROUP":              {"Korean"},
+		"Title.S01E01.Hungarian.720p.HDTV.x264-GROUP":           {"Hungarian"},
+		"Title.S01E01.Hebrew.720p.HDTV.x264-GROUP":              {"Hebrew"},
+		"Title.S01E01.Lithuanian.720p.HDTV.x264-GROUP":          {"Lithuanian"},
+		"Title.S01E01.Czech.720p.HDTV.x264-GROUP":               {"Czech"},
+		"Title.S01E01.German.English.720p.HDTV.x264-GROUP":      {"English", "German"},
+		"Title.S01E01.FRENCH.Spanish.ITA.720p.HDTV.x264-GROUP":  {"French", "Spanish", "Italian"},
+		"Title.S01E01.Eng.Sub.Esp.Kor.Hun.720p.HDTV.x264-GROUP": {"Unknown"}, // These are too short to be sure.
+	}
+
+	for title, wanted := range tests {
+		names := []string{}
+		for _, lang := range starrparse.ParseLanguages(title) {
+			names = append(names, lang.Name)
+		}
+
+		assert.Equal(t, wanted, names, title)
+	}
+}
@@ -0,0 +1,113 @@
+// Package starrparse parses release titles and file names without a Starr app.
+// It extracts the same information the Sonarr and Radarr parse endpoints return,
+// and it returns the same data structures, so it may be used in place of sonarr.Parse
+// when a round trip to the app is too slow, or not possible.
+//
+// This is a port of the most common patterns in the apps' parsers, not all of them.
+// Titles the apps can parse may return ErrNoMatch, and a few may parse differently.
+package starrparse
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrNoMatch is returned when a title does not match any of the known patterns.
+var ErrNoMatch = errors.New("title did not match a known pattern")
+
+// These clean up a title before it's parsed.
+var ( //nolint:gochecknoglobals
+	// extensionRegex matches the file extensions the apps remove from a title.
+	extensionRegex = regexp.MustCompile(`(?i)\.(?:mkv|mp4|m4v|avi|wmv|mpe?g|divx|xvid|ts|m2ts|webm|flv|ogm|nzb|torrent)$`)
+	// websiteRegex matches a website name in front of a title, ie. [www.site.com] - Title.
+	websiteRegex = regexp.MustCompile(`(?i)^(?:\[\s*(?:www\.)?[-a-z0-9]+\.[a-z]{2,6}\s*\]|www\.[-a-z0-9]+\.[a-z]{2,6})[-_. ]+`)
+	// trackerRegex matches the tags some trackers put at the end of a title, ie. [rarbg].
+	trackerRegex = regexp.MustCompile(`(?i)[-_. ]?\[(?:rarbg|rartv|eztv(?:\.re)?|ettv|TGx|eztv\.io)\]$`)
+	// subGroupRegex matches the group name in front of anime titles, ie. [SubsPlease] Title - 01.
+	subGroupRegex = regexp.MustCompile(`^\[(?P<group>[^\]]+?)\][-_. ]*`)
+	// hashRegex matches the CRC32 hash at the end of anime titles, ie. [6B7FD717].
+	hashRegex = regexp.MustCompile(`\[(?P<hash>[0-9A-Fa-f]{8})\](?:[-_. ]|$)`)
+	// titleYearRegex matches a year at the end of a title, ie. Doctor Who (2005).
+	titleYearRegex = regexp.MustCompile(`^(?P<title>.+?)[-_. ]+\(?(?P<year>(?:19|20)\d{2})\)?$`)
+	// spaceRegex matches runs of spaces left behind after separators are replaced.
+	spaceRegex = regexp.MustCompile(`\s{2,}`)
+)
+
+// release is a title that's been cleaned up, and the pieces that were removed from it.
+type release struct {
+	original  string // The title as it was provided.
+	name      string // The title without the extension, website and tracker tags.
+	extension string // The file extension, if there was one. Includes the dot.
+	subGroup  string // The group name in front of an anime title.
+	hash      string // The CRC32 hash in an anime title.
+	body      string // The name without the anime group, used to match patterns.
+}
+
+// newRelease cleans up a title for the parsers.
+func newRelease(title string) *release {
+	rel := &release{original: title, name: strings.TrimSpace(title)}
+
+	if loc := extensionRegex.FindStringIndex(rel.name); loc != nil {
+		rel.extension = strings.ToLower(rel.name[loc[0]:])
+		rel.name = rel.name[:loc[0]]
+	}
+
+	rel.name = websiteRegex.ReplaceAllString(rel.name, "")
+	rel.name = strings.TrimSpace(trackerRegex.ReplaceAllString(rel.name, ""))
+	rel.body = rel.name
+
+	if match := subGroupRegex.FindStringSubmatch(rel.name); match != nil {
+		rel.subGroup = match[1]
+		rel.body = rel.name[len(match[0]):]
+	}
+
+	if match := hashRegex.FindStringSubmatch(rel.name); match != nil {
+		rel.hash = strings.ToUpper(match[1])
+	}
+
+	return rel
+}
+
+// cleanTitle turns separators into spaces, and trims the leftovers, ie. The.Office.US. becomes The Office US.
+func cleanTitle(title string) string {
+	title = strings.NewReplacer(".", " ", "_", " ").Replace(title)
+	title = spaceRegex.ReplaceAllString(title, " ")
+
+	return strings.Trim(title, " -")
+}
+
+// splitTitleYear returns the title without a year at the end, and that year.
+func splitTitleYear(title string) (string, int) {
+	match := titleYearRegex.FindStringSubmatch(title)
+	if match == nil {
+		return title, 0
+	}
+
+	year, _ := strconv.Atoi(match[2])
+
+	return match[1], year
+}
+
+// atoi is strconv.Atoi for numbers that were already matched by a regular expression.
+func atoi(number string) int {
+	val, _ := strconv.Atoi(number)
+	return val
+}
+
+// numberRange returns every number from first to last.
+// Returns nil if the range is backwards, or much too large to be real.
+func numberRange(first, last int) []int {
+	const maxRange = 500
+	if last < first || last-first > maxRange {
+		return nil
+	}
+
+	numbers := make([]int, 0, last-first+1)
+	for num := first; num <= last; num++ {
+		numbers = append(numbers, num)
+	}
+
+	return numbers
+}
@@ -0,0 +1,307 @@
+package starrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+/* This file contains a record-and-replay transport for tests.
+ * Record real request/response pairs from a Starr app once, then replay them in tests.
+ * Plug it into a starr.Config like this:
+ *   cassette, err := starrtest.NewCassette("testdata/series.json", starrtest.ModeRecordOnce, nil)
+ *   config.Client.Transport = cassette
+ *   defer cassette.Save()
+ */
+
+// Redacted replaces API keys and other secrets in recorded cassettes.
+const Redacted = "<redacted>"
+
+// ErrNoInteraction is returned when replaying a request that is not in the cassette.
+var ErrNoInteraction = errors.New("no recorded interaction matches request")
+
+// CassetteMode controls whether a cassette records or replays.
+type CassetteMode int
+
+// These are the cassette modes.
+const (
+	// ModeReplay only replays recorded interactions. Requests not in the cassette return ErrNoInteraction.
+	ModeReplay CassetteMode = iota
+	// ModeRecord sends every request to the next transport and records it. Existing interactions are replaced.
+	ModeRecord
+	// ModeRecordOnce replays the cassette file if it exists, and records a new one if it does not.
+	ModeRecordOnce
+)
+
+// Interaction is a single recorded request and response.
+type Interaction struct {
+	Method      string      `json:"method"`
+	Path        string      `json:"path"`
+	Query       string      `json:"query,omitempty"`
+	RequestBody string      `json:"requestBody,omitempty"`
+	Status      int         `json:"status"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        string      `json:"body"`
+	// used is true after this interaction is replayed.
+	used bool
+}
+
+// Cassette is an http.RoundTripper that records and replays interactions.
+// Requests match on method, path, query and normalized JSON body.
+// The API key (header or query) is never part of a match, and never saved.
+type Cassette struct {
+	// Path is the cassette file.
+	Path string
+	// Redact is a list of strings to replace in saved cassettes, like passwords.
+	// Set it when replaying too, so requests with those secrets match the redacted cassette.
+	// API keys sent in requests are redacted automatically.
+	Redact       []string
+	Interactions []*Interaction
+
+	mode     CassetteMode
+	next     http.RoundTripper
+	recorded bool
+	mu       sync.Mutex
+}
+
+// NewCassette returns a cassette for a file path. The file is loaded if the mode
+// is ModeReplay, or if the mode is ModeRecordOnce and the file exists.
+// Next is the transport used to record. If nil, http.DefaultTransport is used.
+func NewCassette(filePath string, mode CassetteMode, next http.RoundTripper) (*Cassette, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	cassette := &Cassette{Path: filePath, mode: mode, next: next}
+
+	if mode == ModeRecordOnce {
+		if _, err := os.Stat(filePath); err == nil {
+			cassette.mode = ModeReplay
+		} else {
+			cassette.mode = ModeRecord
+		}
+	}
+
+	if cassette.mode != ModeReplay {
+		return cassette, nil
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+
+	if err = json.Unmarshal(data, &cassette.Interactions); err != nil {
+		return nil, fmt.Errorf("decoding cassette %s: %w", filePath, err)
+	}
+
+	return cassette, nil
+}
+
+// Recording returns true if the cassette sends requests to the next transport.
+func (c *Cassette) Recording() bool {
+	return c.mode == ModeRecord
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var sent []byte
+
+	if req.Body != nil {
+		var err error
+		if sent, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(sent))
+	}
+
+	if c.mode == ModeReplay {
+		return c.replay(req, sent)
+	}
+
+	return c.record(req, sent)
+}
+
+func (c *Cassette) replay(req *http.Request, sent []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Saved cassettes are redacted, so redact the live request the same way to match it.
+	match := newInteraction(req, sent, c.Redact)
+
+	var found *Interaction
+
+	// Return matches in recorded order. Once all are used, keep returning the last one.
+	for _, interaction := range c.Interactions {
+		if interaction.matches(match) {
+			if found = interaction; !interaction.used {
+				break
+			}
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.Path)
+	}
+
+	found.used = true
+
+	return found.response(req), nil
+}
+
+func (c *Cassette) record(req *http.Request, sent []byte) (*http.Response, error) {
+	resp, err := c.next.RoundTrip(req)
+	if err != nil {
+		return resp, err //nolint:wrapcheck
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	interaction := newInteraction(req, sent, nil) // Save redacts it.
+	interaction.Status = resp.StatusCode
+	interaction.Body = string(body)
+	interaction.Header = http.Header{}
+
+	// Only keep the headers the clients in this library care about.
+	for _, header := range []string{"Content-Type", "Location"} {
+		if val := resp.Header.Get(header); val != "" {
+			interaction.Header.Set(header, val)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range []string{req.Header.Get("X-API-Key"), req.URL.Query().Get("apikey")} {
+		if key != "" && !contains(c.Redact, key) {
+			c.Redact = append(c.Redact, key)
+		}
+	}
+
+	if !c.recorded {
+		c.recorded, c.Interactions = true, nil
+	}
+
+	c.Interactions = append(c.Interactions, interaction)
+
+	return resp, nil
+}
+
+// Save writes the recorded interactions to the cassette file, with secrets redacted.
+// Save does nothing when the cassette is replaying.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.mode == ModeReplay || !c.recorded {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(c.Interactions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+
+	data = redact(data, c.Redact)
+
+	if err = os.MkdirAll(filepath.Dir(c.Path), 0o755); err != nil { //nolint:gomnd
+		return fmt.Errorf("creating cassette directory: %w", err)
+	}
+
+	if err = os.WriteFile(c.Path, data, 0o600); err != nil { //nolint:gomnd
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+
+	return nil
+}
+
+// newInteraction returns an interaction with the request match values filled in.
+// Secrets are redacted from the query and body before the body is normalized.
+func newInteraction(req *http.Request, sent []byte, secrets []string) *Interaction {
+	query := req.URL.Query()
+	query.Del("apikey")
+
+	return &Interaction{
+		Method:      req.Method,
+		Path:        req.URL.Path,
+		Query:       string(redact([]byte(query.Encode()), secrets)), // sorted by key.
+		RequestBody: normalizeBody(redact(sent, secrets)),
+	}
+}
+
+// redact replaces every secret in data with Redacted.
+func redact(data []byte, secrets []string) []byte {
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+
+		data = bytes.ReplaceAll(data, []byte(secret), []byte(Redacted))
+		// Secrets in query strings and bodies may be escaped.
+		data = bytes.ReplaceAll(data, []byte(url.QueryEscape(secret)), []byte(Redacted))
+	}
+
+	return data
+}
+
+func (i *Interaction) matches(match *Interaction) bool {
+	return i.Method == match.Method && i.Path == match.Path &&
+		i.Query == match.Query && normalizeBody([]byte(i.RequestBody)) == match.RequestBody
+}
+
+func (i *Interaction) response(req *http.Request) *http.Response {
+	header := i.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.Status, http.StatusText(i.Status)),
+		StatusCode:    i.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(i.Body)),
+		ContentLength: int64(len(i.Body)),
+		Request:       req,
+	}
+}
+
+// normalizeBody re-encodes JSON so key order and white space do not affect matching.
+// Bodies that are not JSON are only trimmed.
+func normalizeBody(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return strings.TrimSpace(string(body))
+	}
+
+	normal, _ := json.Marshal(data) // Map keys are sorted when marshaled.
+
+	return string(normal)
+}
+
+func contains(list []string, item string) bool {
+	for _, val := range list {
+		if val == item {
+			return true
+		}
+	}
+
+	return false
+}
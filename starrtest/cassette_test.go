@@ -0,0 +1,123 @@
+package starrtest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestCassette(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "testdata", "sonarr.json")
+	fake := starrtest.NewFakeSonarr(t, "secretAPIkey")
+
+	// Record.
+	cassette, err := starrtest.NewCassette(file, starrtest.ModeRecordOnce, nil)
+	require.NoError(t, err)
+	assert.True(t, cassette.Recording(), "the cassette file does not exist yet")
+
+	config := starr.New("secretAPIkey", fake.URL, 0)
+	config.Client.Transport = cassette
+	client := sonarr.New(config)
+
+	tag, err := client.AddTag(&starr.Tag{Label: "recorded"})
+	require.NoError(t, err)
+	_, err = client.GetTags()
+	require.NoError(t, err)
+	require.NoError(t, cassette.Save())
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secretAPIkey", "the api key must be redacted")
+
+	// Replay. The server is closed, so any request not in the cassette fails.
+	fake.Close()
+
+	cassette, err = starrtest.NewCassette(file, starrtest.ModeRecordOnce, nil)
+	require.NoError(t, err)
+	assert.False(t, cassette.Recording(), "the cassette file exists")
+
+	config = starr.New("anotherKey", fake.URL, 0)
+	config.Client.Transport = cassette
+	client = sonarr.New(config)
+
+	replayed, err := client.AddTag(&starr.Tag{Label: "recorded"})
+	require.NoError(t, err)
+	assert.Equal(t, tag, replayed)
+
+	tags, err := client.GetTags()
+	require.NoError(t, err)
+	assert.Equal(t, []*starr.Tag{tag}, tags)
+
+	_, err = client.AddTag(&starr.Tag{Label: "not recorded"})
+	assert.ErrorIs(t, err, starrtest.ErrNoInteraction)
+}
+
+func TestCassetteNormalizedBody(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := `[{"method":"POST","path":"/api/v3/tag","requestBody":" {\"label\":  \"tv\"}\n",` +
+		`"status":201,"header":{"Content-Type":["application/json"]},"body":"{\"id\":3,\"label\":\"tv\"}"}]`
+	require.NoError(t, os.WriteFile(file, []byte(cassette), 0o600))
+
+	replay, err := starrtest.NewCassette(file, starrtest.ModeReplay, nil)
+	require.NoError(t, err)
+
+	config := starr.New("mockAPIkey", "http://127.0.0.1:1", 0)
+	config.Client.Transport = replay
+
+	tag, err := sonarr.New(config).AddTag(&starr.Tag{Label: "tv"})
+	require.NoError(t, err, "json white space must not matter")
+	assert.Equal(t, &starr.Tag{ID: 3, Label: "tv"}, tag)
+}
+
+func TestCassetteRedactedBody(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "sonarr.json")
+	fake := starrtest.NewFakeSonarr(t, "secretAPIkey")
+	input := &sonarr.DownloadClientInput{
+		Name:           "SABnzbd",
+		Implementation: "Sabnzbd",
+		Fields:         []*starr.FieldInput{{Name: "password", Value: "hunter2"}},
+	}
+
+	// Record, with the password in the request body.
+	cassette, err := starrtest.NewCassette(file, starrtest.ModeRecord, nil)
+	require.NoError(t, err)
+
+	cassette.Redact = []string{"hunter2"}
+	config := starr.New("secretAPIkey", fake.URL, 0)
+	config.Client.Transport = cassette
+
+	recorded, err := sonarr.New(config).AddDownloadClient(input)
+	require.NoError(t, err)
+	require.NoError(t, cassette.Save())
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2", "the password must be redacted")
+
+	// Replay the same request. It must match the redacted interaction.
+	fake.Close()
+
+	cassette, err = starrtest.NewCassette(file, starrtest.ModeReplay, nil)
+	require.NoError(t, err)
+
+	cassette.Redact = []string{"hunter2"}
+	config = starr.New("anotherKey", fake.URL, 0)
+	config.Client.Transport = cassette
+
+	replayed, err := sonarr.New(config).AddDownloadClient(input)
+	require.NoError(t, err, "the live request body must be redacted before matching")
+	assert.Equal(t, recorded.ID, replayed.ID)
+	assert.Equal(t, recorded.Name, replayed.Name)
+}
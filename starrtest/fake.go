@@ -0,0 +1,505 @@
+package starrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+/* This file contains stateful, in-memory fake Starr apps.
+ * They keep records in memory so a test can run a whole workflow against one server.
+ * Records are stored as generic JSON maps, so any payload a client sends is kept as-is.
+ */
+
+// Failure is a fake failure injected into a FakeServer with AddFailure.
+type Failure struct {
+	// Method to fail. Empty matches any method.
+	Method string
+	// Path prefix to fail, ie. /api/v3/series. Matching ignores case.
+	Path string
+	// Status code to return.
+	Status int
+	// Body to return with the status code.
+	Body string
+	// Fail this many requests, then stop failing. 0 fails forever.
+	Count int
+}
+
+// FakeServer is a stateful, in-memory Starr app. Create one with NewFakeSonarr or NewFakeRadarr.
+// The server is closed when the test that created it finishes.
+type FakeServer struct {
+	*httptest.Server
+	// APIKey must be provided in every API request, or the server returns a 401.
+	APIKey string
+	// App is the name of the fake app. It is returned in the system status.
+	App string
+	// Version is returned in the system status. Change it before making requests.
+	Version string
+
+	t         testing.TB
+	apiPath   string
+	mu        sync.Mutex
+	resources map[string]*fakeResource
+	failures  []*Failure
+	requests  []string
+}
+
+// fakeResource holds the records for one API endpoint.
+type fakeResource struct {
+	// paged resources return page-able responses, like queue and history.
+	paged bool
+	// unique is the record key that may not be duplicated, ie. tvdbId.
+	unique  string
+	nextID  int64
+	records []map[string]interface{}
+}
+
+// These resources are shared by all the fake apps.
+func fakeResources() map[string]*fakeResource {
+	return map[string]*fakeResource{
+		"blocklist":         {paged: true},
+		"command":           {},
+		"customformat":      {unique: "name"},
+		"delayprofile":      {},
+		"downloadclient":    {unique: "name"},
+		"history":           {paged: true},
+		"indexer":           {unique: "name"},
+		"notification":      {unique: "name"},
+		"qualityprofile":    {unique: "name"},
+		"queue":             {paged: true},
+		"remotepathmapping": {},
+		"rootfolder":        {unique: "path"},
+		"tag":               {unique: "label"},
+	}
+}
+
+// NewFakeSonarr returns a fake Sonarr server that keeps series, episodes,
+// tags, profiles, queue and history records (and more) in memory.
+func NewFakeSonarr(t testing.TB, apiKey string) *FakeServer {
+	t.Helper()
+
+	resources := fakeResources()
+	resources["series"] = &fakeResource{unique: "tvdbId"}
+	resources["episode"] = &fakeResource{}
+	resources["episodefile"] = &fakeResource{}
+	resources["languageprofile"] = &fakeResource{unique: "name"}
+	resources["releaseprofile"] = &fakeResource{}
+
+	return newFakeServer(t, "Sonarr", "/api/v3/", apiKey, resources)
+}
+
+// NewFakeRadarr returns a fake Radarr server that keeps movies,
+// tags, profiles, queue and history records (and more) in memory.
+func NewFakeRadarr(t testing.TB, apiKey string) *FakeServer {
+	t.Helper()
+
+	resources := fakeResources()
+	resources["movie"] = &fakeResource{unique: "tmdbId"}
+	resources["moviefile"] = &fakeResource{}
+	resources["importlist"] = &fakeResource{unique: "name"}
+	resources["exclusions"] = &fakeResource{unique: "tmdbId"}
+
+	return newFakeServer(t, "Radarr", "/api/v3/", apiKey, resources)
+}
+
+func newFakeServer(t testing.TB, app, apiPath, apiKey string, resources map[string]*fakeResource) *FakeServer {
+	t.Helper()
+
+	fake := &FakeServer{
+		APIKey:    apiKey,
+		App:       app,
+		Version:   "4.0.0.0",
+		t:         t,
+		apiPath:   apiPath,
+		resources: resources,
+	}
+	fake.Server = httptest.NewServer(fake)
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+// AddFailure makes the server return a failure for matching requests.
+// Failures are checked in the order they are added, and before the API key is checked.
+// The server keeps a copy, so one Failure may be added to many servers, and its Count is not changed.
+func (f *FakeServer) AddFailure(failure *Failure) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	copied := *failure
+	f.failures = append(f.failures, &copied)
+}
+
+// Seed adds records to a resource, ie. "series" or "tag". Records may be any type that
+// marshals to a JSON object, like a *sonarr.Series. Records without an id are given one,
+// and a record with an id that is already in the resource replaces that record.
+func (f *FakeServer) Seed(resource string, records ...interface{}) {
+	f.t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.resources[strings.ToLower(resource)]
+	require.True(f.t, ok, "fake %s has no resource named %s", f.App, resource)
+
+	for _, record := range records {
+		body, err := json.Marshal(record)
+		require.NoError(f.t, err, "marshaling seed record")
+
+		item, err := decodeRecord(bytes.NewReader(body))
+		require.NoError(f.t, err, "decoding seed record")
+		res.add(item)
+	}
+}
+
+// Records returns a copy of the records in a resource. Useful to check the state after a workflow.
+func (f *FakeServer) Records(resource string) []map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.resources[strings.ToLower(resource)]
+	if !ok {
+		return nil
+	}
+
+	output := make([]map[string]interface{}, len(res.records))
+
+	for idx, record := range res.records {
+		output[idx] = make(map[string]interface{}, len(record))
+		for key, val := range record {
+			output[idx][key] = val
+		}
+	}
+
+	return output
+}
+
+// Requests returns every request the server received as "METHOD /path".
+func (f *FakeServer) Requests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string{}, f.requests...)
+}
+
+// ServeHTTP satisfies the http.Handler interface.
+func (f *FakeServer) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, req.Method+" "+req.URL.Path)
+	uri := strings.ToLower(req.URL.Path)
+
+	if failure := f.failure(req.Method, uri); failure != nil {
+		writeBody(writer, failure.Status, failure.Body)
+		return
+	}
+
+	switch {
+	case uri == "/ping":
+		writeBody(writer, http.StatusOK, `{"status":"OK"}`)
+	case !strings.HasPrefix(uri, f.apiPath):
+		writeBody(writer, http.StatusNotFound, BodyNotFound)
+	case req.Header.Get("X-API-Key") != f.APIKey && req.URL.Query().Get("apikey") != f.APIKey:
+		writeBody(writer, http.StatusUnauthorized, BodyUnauthorized)
+	case uri == f.apiPath+"system/status":
+		writeJSON(writer, http.StatusOK, map[string]interface{}{
+			"appName": f.App, "instanceName": f.App, "version": f.Version, "urlBase": "",
+		})
+	default:
+		f.serveResource(writer, req, strings.Split(strings.Trim(strings.TrimPrefix(uri, f.apiPath), "/"), "/"))
+	}
+}
+
+// failure returns the first matching failure. The caller must hold the lock.
+func (f *FakeServer) failure(method, uri string) *Failure {
+	for idx, failure := range f.failures {
+		if (failure.Method != "" && failure.Method != method) || !strings.HasPrefix(uri, strings.ToLower(failure.Path)) {
+			continue
+		}
+
+		if failure.Count > 0 {
+			if failure.Count--; failure.Count == 0 {
+				f.failures = append(f.failures[:idx], f.failures[idx+1:]...)
+			}
+		}
+
+		return failure
+	}
+
+	return nil
+}
+
+// serveResource handles the CRUD endpoints. The caller must hold the lock.
+func (f *FakeServer) serveResource(writer http.ResponseWriter, req *http.Request, parts []string) {
+	res, ok := f.resources[parts[0]]
+	if !ok {
+		writeBody(writer, http.StatusNotFound, BodyNotFound)
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		f.serveCollection(writer, req, parts[0], res)
+	case parts[0] == "queue" && len(parts) > 1 && parts[len(parts)-1] == "bulk":
+		f.serveQueueBulk(writer, req, res, parts[1])
+	case len(parts) == 2: //nolint:gomnd
+		f.serveRecord(writer, req, res, parts[1])
+	default:
+		writeBody(writer, http.StatusNotFound, BodyNotFound)
+	}
+}
+
+func (f *FakeServer) serveCollection(writer http.ResponseWriter, req *http.Request, name string, res *fakeResource) {
+	switch req.Method {
+	case http.MethodGet:
+		records := res.filter(req)
+		if !res.paged {
+			writeJSON(writer, http.StatusOK, records)
+			return
+		}
+
+		writeJSON(writer, http.StatusOK, page(req, records))
+	case http.MethodPost:
+		record, err := decodeRecord(req.Body)
+		if err != nil {
+			writeBody(writer, http.StatusBadRequest, fmt.Sprintf(`{"message":%q}`, err.Error()))
+			return
+		}
+
+		if dupe := res.duplicate(record); dupe != "" {
+			writeJSON(writer, http.StatusBadRequest, []map[string]string{{
+				"propertyName": dupe, "errorMessage": "This " + name + " has already been added", "severity": "error",
+			}})
+
+			return
+		}
+
+		if name == "command" {
+			record["status"] = "completed"
+			record["commandName"] = record["name"]
+			record["queued"] = time.Now().UTC()
+		}
+
+		res.add(record)
+		writeJSON(writer, http.StatusCreated, record)
+	case http.MethodPut:
+		// Some endpoints put the ID in the body, not the path.
+		f.serveRecord(writer, req, res, "")
+	default:
+		writeBody(writer, http.StatusMethodNotAllowed, BodyMethodNotAllowed)
+	}
+}
+
+func (f *FakeServer) serveRecord(writer http.ResponseWriter, req *http.Request, res *fakeResource, id string) {
+	var (
+		record map[string]interface{}
+		err    error
+	)
+
+	if req.Method == http.MethodPut {
+		if record, err = decodeRecord(req.Body); err != nil {
+			writeBody(writer, http.StatusBadRequest, fmt.Sprintf(`{"message":%q}`, err.Error()))
+			return
+		}
+
+		if id == "" {
+			id = fmt.Sprint(record["id"])
+		}
+	}
+
+	idx := res.index(id)
+	if idx < 0 {
+		writeBody(writer, http.StatusNotFound, BodyNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		writeJSON(writer, http.StatusOK, res.records[idx])
+	case http.MethodPut:
+		record["id"] = res.records[idx]["id"]
+		res.records[idx] = record
+		writeJSON(writer, http.StatusAccepted, record)
+	case http.MethodDelete:
+		res.records = append(res.records[:idx], res.records[idx+1:]...)
+		writeBody(writer, http.StatusOK, "")
+	default:
+		writeBody(writer, http.StatusMethodNotAllowed, BodyMethodNotAllowed)
+	}
+}
+
+// serveQueueBulk handles the /queue/bulk and /queue/grab/bulk endpoints.
+func (f *FakeServer) serveQueueBulk(writer http.ResponseWriter, req *http.Request, res *fakeResource, action string) {
+	var input struct {
+		IDs []int64 `json:"ids"`
+	}
+
+	if err := json.NewDecoder(req.Body).Decode(&input); err != nil {
+		writeBody(writer, http.StatusBadRequest, fmt.Sprintf(`{"message":%q}`, err.Error()))
+		return
+	}
+
+	for _, id := range input.IDs {
+		idx := res.index(strconv.FormatInt(id, 10)) //nolint:gomnd
+		if idx < 0 {
+			continue
+		}
+
+		if action == "grab" {
+			res.records[idx]["status"] = "downloading"
+		} else if req.Method == http.MethodDelete {
+			res.records = append(res.records[:idx], res.records[idx+1:]...)
+		}
+	}
+
+	writeBody(writer, http.StatusOK, "{}")
+}
+
+// add a record. A record without an id is given the next one, and a record
+// with an id already in the resource replaces the record with that id.
+func (r *fakeResource) add(record map[string]interface{}) {
+	id := recordID(record)
+	if id < 1 {
+		r.nextID++
+		record["id"] = r.nextID
+		r.records = append(r.records, record)
+
+		return
+	}
+
+	if id > r.nextID {
+		r.nextID = id
+	}
+
+	if idx := r.index(strconv.FormatInt(id, 10)); idx >= 0 { //nolint:gomnd
+		r.records[idx] = record
+		return
+	}
+
+	r.records = append(r.records, record)
+}
+
+func (r *fakeResource) index(id string) int {
+	for idx, record := range r.records {
+		if fmt.Sprint(record["id"]) == id {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// duplicate returns the name of the unique key if the record is already in the resource.
+func (r *fakeResource) duplicate(record map[string]interface{}) string {
+	if r.unique == "" || record[r.unique] == nil {
+		return ""
+	}
+
+	for _, existing := range r.records {
+		if fmt.Sprint(existing[r.unique]) == fmt.Sprint(record[r.unique]) {
+			return r.unique
+		}
+	}
+
+	return ""
+}
+
+// filter returns the records that match the request query parameters, ie. tvdbId=1234.
+// Parameters that are not a record key, like page or excludeLocalCovers, are ignored.
+func (r *fakeResource) filter(req *http.Request) []map[string]interface{} {
+	output := []map[string]interface{}{}
+
+	for _, record := range r.records {
+		match := true
+
+		for key := range req.URL.Query() {
+			if val, ok := record[key]; ok && fmt.Sprint(val) != req.URL.Query().Get(key) {
+				match = false
+			}
+		}
+
+		if match {
+			output = append(output, record)
+		}
+	}
+
+	return output
+}
+
+// page turns records into a page-able response, like the queue and history endpoints return.
+func page(req *http.Request, records []map[string]interface{}) map[string]interface{} {
+	pageNum, _ := strconv.Atoi(req.URL.Query().Get("page"))
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	pageSize, _ := strconv.Atoi(req.URL.Query().Get("pageSize"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	start := (pageNum - 1) * pageSize
+	if start > len(records) {
+		start = len(records)
+	}
+
+	end := start + pageSize
+	if end > len(records) {
+		end = len(records)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return recordID(records[i]) < recordID(records[j])
+	})
+
+	return map[string]interface{}{
+		"page":          pageNum,
+		"pageSize":      pageSize,
+		"sortKey":       req.URL.Query().Get("sortKey"),
+		"sortDirection": req.URL.Query().Get("sortDirection"),
+		"totalRecords":  len(records),
+		"records":       records[start:end],
+	}
+}
+
+// recordID returns a record's id as a number. Returns 0 if the record has no numeric id.
+func recordID(record map[string]interface{}) int64 {
+	id, _ := strconv.ParseInt(fmt.Sprint(record["id"]), 10, 64) //nolint:gomnd
+	return id
+}
+
+func decodeRecord(body io.Reader) (map[string]interface{}, error) {
+	record := make(map[string]interface{})
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&record); err != nil {
+		return nil, fmt.Errorf("decoding request body: %w", err)
+	}
+
+	return record, nil
+}
+
+func writeJSON(writer http.ResponseWriter, status int, output interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(output)
+}
+
+func writeBody(writer http.ResponseWriter, status int, body string) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_, _ = writer.Write([]byte(body))
+}
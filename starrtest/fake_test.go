@@ -0,0 +1,171 @@
+package starrtest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestFakeSonarr(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr(t, "mockAPIkey")
+	client := sonarr.New(starr.New("mockAPIkey", fake.URL, 0))
+
+	status, err := client.GetSystemStatus()
+	require.NoError(t, err)
+	assert.Equal(t, "Sonarr", status.AppName)
+
+	tag, err := client.AddTag(&starr.Tag{Label: "tv"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, tag.ID)
+
+	series, err := client.AddSeries(&sonarr.AddSeriesInput{TvdbID: 1234, Title: "Test", Tags: []int{tag.ID}})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, series.ID)
+	assert.Equal(t, []int{tag.ID}, series.Tags)
+
+	_, err = client.AddSeries(&sonarr.AddSeriesInput{TvdbID: 1234, Title: "Test"})
+	assert.ErrorIs(t, err, starr.ErrInvalidStatusCode, "adding a duplicate series must fail")
+
+	found, err := client.GetSeries(1234)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "Test", found[0].Title)
+
+	found, err = client.GetSeries(5678)
+	require.NoError(t, err)
+	assert.Empty(t, found, "no series has this tvdb id")
+
+	cmd, err := client.SendCommand(&sonarr.CommandRequest{Name: "SeriesSearch", SeriesID: series.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "completed", cmd.Status)
+
+	fake.Seed("queue", &sonarr.QueueRecord{SeriesID: series.ID, Title: "Test.S01E01"},
+		&sonarr.QueueRecord{SeriesID: series.ID, Title: "Test.S01E02"})
+
+	queue, err := client.GetQueue(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, queue.TotalRecords)
+	assert.Len(t, queue.Records, 2)
+
+	require.NoError(t, client.DeleteQueue(queue.Records[0].ID, nil))
+	assert.Len(t, fake.Records("queue"), 1)
+
+	require.NoError(t, client.DeleteSeriesDefault(int(series.ID)))
+	assert.Empty(t, fake.Records("series"))
+}
+
+func TestFakeRadarr(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeRadarr(t, "mockAPIkey")
+	client := radarr.New(starr.New("mockAPIkey", fake.URL, 0))
+
+	fake.Seed("movie", &radarr.Movie{Title: "Seeded", TmdbID: 1})
+
+	movie, err := client.AddMovie(&radarr.AddMovieInput{Title: "Added", TmdbID: 2, RootFolderPath: "/movies"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, movie.ID)
+
+	movie.Monitored = true
+	_, err = client.UpdateMovie(movie.ID, movie, false)
+	require.NoError(t, err)
+
+	movie, err = client.GetMovieByID(movie.ID)
+	require.NoError(t, err)
+	assert.True(t, movie.Monitored)
+
+	movies, err := client.GetMovie(&radarr.GetMovie{TMDBID: 1})
+	require.NoError(t, err)
+	require.Len(t, movies, 1)
+	assert.Equal(t, "Seeded", movies[0].Title)
+
+	_, err = client.GetMovieByID(99)
+	assert.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+}
+
+func TestFakeServerPages(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr(t, "mockAPIkey")
+	client := sonarr.New(starr.New("mockAPIkey", fake.URL, 0))
+
+	for i := 0; i < 12; i++ {
+		fake.Seed("queue", &sonarr.QueueRecord{Title: "Test"})
+	}
+
+	// This replaces record 5, it must not add a second record with id 5.
+	fake.Seed("queue", &sonarr.QueueRecord{ID: 5, Title: "Replaced"})
+	assert.Len(t, fake.Records("queue"), 12)
+
+	queue, err := client.GetQueuePage(&starr.PageReq{Page: 2, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 12, queue.TotalRecords)
+	require.Len(t, queue.Records, 2)
+	assert.EqualValues(t, 11, queue.Records[0].ID, "ids must sort as numbers")
+	assert.EqualValues(t, 12, queue.Records[1].ID, "ids must sort as numbers")
+
+	queue, err = client.GetQueuePage(&starr.PageReq{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, queue.Records, 10)
+	assert.EqualValues(t, 2, queue.Records[1].ID)
+	assert.Equal(t, "Replaced", queue.Records[4].Title)
+}
+
+func TestFakeServerFailures(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeRadarr(t, "mockAPIkey")
+	client := radarr.New(starr.New("mockAPIkey", fake.URL, 0))
+
+	fake.AddFailure(&starrtest.Failure{
+		Method: http.MethodGet,
+		Path:   "/api/v3/tag",
+		Status: http.StatusInternalServerError,
+		Body:   `{"message":"boom"}`,
+		Count:  1,
+	})
+
+	_, err := client.GetTags()
+	assert.ErrorIs(t, err, starr.ErrInvalidStatusCode, "the first request must fail")
+
+	tags, err := client.GetTags()
+	require.NoError(t, err, "only one request may fail")
+	assert.Empty(t, tags)
+
+	badKey := radarr.New(starr.New("wrongKey", fake.URL, 0))
+	_, err = badKey.GetTags()
+
+	var reqErr *starr.ReqError
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, http.StatusUnauthorized, reqErr.Code)
+	assert.Contains(t, fake.Requests(), "GET /api/v3/tag")
+}
+
+func TestFakeServerFailureCopy(t *testing.T) {
+	t.Parallel()
+
+	failure := &starrtest.Failure{Path: "/api/v3/tag", Status: http.StatusBadGateway, Count: 1}
+
+	for _, fake := range []*starrtest.FakeServer{
+		starrtest.NewFakeRadarr(t, "mockAPIkey"),
+		starrtest.NewFakeSonarr(t, "mockAPIkey"),
+	} {
+		fake.AddFailure(failure)
+
+		_, err := radarr.New(starr.New("mockAPIkey", fake.URL, 0)).GetTags()
+		assert.ErrorIs(t, err, starr.ErrInvalidStatusCode, "each server must fail once")
+
+		_, err = radarr.New(starr.New("mockAPIkey", fake.URL, 0)).GetTags()
+		require.NoError(t, err, "each server may only fail once")
+	}
+
+	assert.Equal(t, 1, failure.Count, "the server must not change the caller's failure")
+}
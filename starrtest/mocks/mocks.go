@@ -0,0 +1,49 @@
+// Package mocks provides mocks for every starr app client.
+// Each mock satisfies its app's API interface, ie. sonarr.API, so code that
+// depends on those interfaces can be unit tested without an HTTP server.
+//
+//	mock := &mocks.Sonarr{
+//		GetSeriesFunc: func(tvdbID int64) ([]*sonarr.Series, error) {
+//			return []*sonarr.Series{{ID: 1, TvdbID: tvdbID}}, nil
+//		},
+//	}
+//	series, err := yourCode(mock) // accepts sonarr.SeriesAPI.
+//	count := mock.Calls("GetSeries")
+//
+// The mocks are generated from each app's api.go file. Run go generate after changing one.
+package mocks
+
+import (
+	"errors"
+	"sync"
+)
+
+//go:generate go run ./internal/mockgen
+
+// ErrNotMocked is returned by mock methods that do not have a Func set.
+var ErrNotMocked = errors.New("method is not mocked")
+
+// calls counts mock method calls. It is embedded in every mock.
+type calls struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (c *calls) called(method string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts == nil {
+		c.counts = make(map[string]int)
+	}
+
+	c.counts[method]++
+}
+
+// Calls returns the number of times a method was called on the mock.
+func (c *calls) Calls(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.counts[method]
+}
@@ -0,0 +1,105 @@
+package mocks_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest/mocks"
+	"golift.io/starr/whisparr"
+)
+
+// countTags is an example of code that depends on a small interface.
+func countTags(api sonarr.TagAPI) (int, error) {
+	tags, err := api.GetTags()
+	return len(tags), err
+}
+
+func TestSonarr(t *testing.T) {
+	t.Parallel()
+
+	mock := &mocks.Sonarr{
+		GetTagsFunc: func() ([]*starr.Tag, error) {
+			return []*starr.Tag{{ID: 1, Label: "one"}, {ID: 2, Label: "two"}}, nil
+		},
+	}
+
+	count, err := countTags(mock)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 1, mock.Calls("GetTags"))
+	assert.Equal(t, 0, mock.Calls("GetSeries"))
+
+	_, err = mock.GetSeries(1234)
+	require.ErrorIs(t, err, mocks.ErrNotMocked)
+	assert.Equal(t, 1, mock.Calls("GetSeries"))
+}
+
+func TestContextFallback(t *testing.T) {
+	t.Parallel()
+
+	mock := &mocks.Radarr{
+		GetMovieByIDContextFunc: func(_ context.Context, movieID int64) (*radarr.Movie, error) {
+			return &radarr.Movie{ID: movieID}, nil
+		},
+	}
+
+	movie, err := mock.GetMovieByID(5)
+	require.NoError(t, err, "the Context func must be used when the plain func is nil")
+	assert.EqualValues(t, 5, movie.ID)
+}
+
+// TestAPIInterfaces makes sure every exported method is in each app's API interface,
+// and that each mock has no methods besides the API interface and Calls.
+// If this fails, add the new method to the app's api.go file, and run go generate in this folder.
+func TestAPIInterfaces(t *testing.T) {
+	t.Parallel()
+
+	apier := reflect.TypeOf((*starr.APIer)(nil)).Elem()
+	apps := []struct {
+		app  reflect.Type
+		api  reflect.Type
+		mock reflect.Type
+	}{
+		{reflect.TypeOf(&lidarr.Lidarr{}), reflect.TypeOf((*lidarr.API)(nil)).Elem(), reflect.TypeOf(&mocks.Lidarr{})},
+		{reflect.TypeOf(&prowlarr.Prowlarr{}), reflect.TypeOf((*prowlarr.API)(nil)).Elem(), reflect.TypeOf(&mocks.Prowlarr{})},
+		{reflect.TypeOf(&radarr.Radarr{}), reflect.TypeOf((*radarr.API)(nil)).Elem(), reflect.TypeOf(&mocks.Radarr{})},
+		{reflect.TypeOf(&readarr.Readarr{}), reflect.TypeOf((*readarr.API)(nil)).Elem(), reflect.TypeOf(&mocks.Readarr{})},
+		{reflect.TypeOf(&sonarr.Sonarr{}), reflect.TypeOf((*sonarr.API)(nil)).Elem(), reflect.TypeOf(&mocks.Sonarr{})},
+		{reflect.TypeOf(&whisparr.Whisparr{}), reflect.TypeOf((*whisparr.API)(nil)).Elem(), reflect.TypeOf(&mocks.Whisparr{})},
+	}
+
+	for _, test := range apps {
+		test := test
+		t.Run(test.app.Elem().Name(), func(t *testing.T) {
+			t.Parallel()
+
+			for idx := 0; idx < test.app.NumMethod(); idx++ {
+				name := test.app.Method(idx).Name
+				if _, ok := apier.MethodByName(name); ok {
+					continue
+				}
+
+				_, ok := test.api.MethodByName(name)
+				assert.True(t, ok, "%s.%s is missing from the API interface", test.app.Elem(), name)
+			}
+
+			for idx := 0; idx < test.mock.NumMethod(); idx++ {
+				name := test.mock.Method(idx).Name
+				_, ok := test.api.MethodByName(name)
+				assert.True(t, ok || name == "Calls", "%s.%s is not in the API interface", test.mock.Elem(), name)
+			}
+
+			// The mock has every API method, plus Calls.
+			assert.Equal(t, test.api.NumMethod()+1, test.mock.NumMethod(), "mock is out of date, run go generate")
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package whisparr
+
+import (
+	"context"
+	"time"
+
+	"golift.io/starr"
+)
+
+/* This file contains interfaces for the Whisparr methods, grouped by resource.
+ * Depend on one of these, or on API, instead of *Whisparr to swap in a fake during tests.
+ * The starrtest/mocks package has a ready-made Whisparr mock that satisfies API.
+ */
+
+// API contains every Whisparr method, except the methods from the embedded starr.APIer.
+type API interface {
+	CalendarAPI
+	CommandAPI
+	CustomFormatAPI
+	DownloadClientAPI
+	HistoryAPI
+	IndexerAPI
+	MovieAPI
+	MovieEditorAPI
+	MovieFileAPI
+	QualityProfileAPI
+	QueueAPI
+	SystemAPI
+	TagAPI
+}
+
+// Whisparr must satisfy the API interface.
+var _ API = (*Whisparr)(nil)
+
+// CalendarAPI contains the Whisparr methods for the calendar.
+type CalendarAPI interface {
+	GetCalendar(filter Calendar) ([]*Movie, error)
+	GetCalendarContext(ctx context.Context, filter Calendar) ([]*Movie, error)
+}
+
+// CommandAPI contains the Whisparr methods for commands.
+type CommandAPI interface {
+	GetCommands() ([]*CommandResponse, error)
+	GetCommandsContext(ctx context.Context) ([]*CommandResponse, error)
+	SendCommand(cmd *CommandRequest) (*CommandResponse, error)
+	SendCommandContext(ctx context.Context, cmd *CommandRequest) (*CommandResponse, error)
+}
+
+// CustomFormatAPI contains the Whisparr methods for custom formats.
+type CustomFormatAPI interface {
+	GetCustomFormats() ([]*CustomFormatOutput, error)
+	GetCustomFormatsContext(ctx context.Context) ([]*CustomFormatOutput, error)
+	GetCustomFormat(customformatID int64) (*CustomFormatOutput, error)
+	GetCustomFormatContext(ctx context.Context, customformatID int64) (*CustomFormatOutput, error)
+	AddCustomFormat(format *CustomFormatInput) (*CustomFormatOutput, error)
+	AddCustomFormatContext(ctx context.Context, format *CustomFormatInput) (*CustomFormatOutput, error)
+	UpdateCustomFormat(cf *CustomFormatInput) (*CustomFormatOutput, error)
+	UpdateCustomFormatContext(ctx context.Context, format *CustomFormatInput) (*CustomFormatOutput, error)
+	DeleteCustomFormat(cfID int64) error
+	DeleteCustomFormatContext(ctx context.Context, cfID int64) error
+}
+
+// DownloadClientAPI contains the Whisparr methods for download clients.
+type DownloadClientAPI interface {
+	GetDownloadClients() ([]*DownloadClientOutput, error)
+	GetDownloadClientsContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	GetDownloadClient(downloadclientID int64) (*DownloadClientOutput, error)
+	GetDownloadClientContext(ctx context.Context, downloadclientID int64) (*DownloadClientOutput, error)
+	AddDownloadClient(downloadclient *DownloadClientInput) (*DownloadClientOutput, error)
+	AddDownloadClientContext(ctx context.Context, client *DownloadClientInput) (*DownloadClientOutput, error)
+	TestDownloadClient(client *DownloadClientInput) error
+	TestDownloadClientContext(ctx context.Context, client *DownloadClientInput) error
+	UpdateDownloadClient(downloadclient *DownloadClientInput, force bool) (*DownloadClientOutput, error)
+	UpdateDownloadClientContext(ctx context.Context,
+		client *DownloadClientInput,
+		force bool,
+	) (*DownloadClientOutput, error)
+	DeleteDownloadClient(downloadclientID int64) error
+	DeleteDownloadClientContext(ctx context.Context, downloadclientID int64) error
+	GetDownloadClientSchema() ([]*DownloadClientOutput, error)
+	GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClients(bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	UpdateBulkDownloadClientsContext(ctx context.Context, bulk *BulkDownloadClient) ([]*DownloadClientOutput, error)
+	DeleteBulkDownloadClients(ids []int64) error
+	DeleteBulkDownloadClientsContext(ctx context.Context, ids []int64) error
+	TestAllDownloadClients() ([]*starr.ProviderTestResult, error)
+	TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// HistoryAPI contains the Whisparr methods for history.
+type HistoryAPI interface {
+	GetHistory(records, perPage int) (*History, error)
+	GetHistoryContext(ctx context.Context, records, perPage int) (*History, error)
+	GetHistoryPage(params *starr.PageReq) (*History, error)
+	GetHistoryPageContext(ctx context.Context, params *starr.PageReq) (*History, error)
+	GetHistorySince(date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetHistorySinceContext(ctx context.Context, date time.Time, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetMovieHistory(movieID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	GetMovieHistoryContext(ctx context.Context, movieID int64, filter starr.Filtering) ([]*HistoryRecord, error)
+	Fail(historyID int64) error
+	FailContext(ctx context.Context, historyID int64) error
+}
+
+// IndexerAPI contains the Whisparr methods for indexers.
+type IndexerAPI interface {
+	GetIndexers() ([]*IndexerOutput, error)
+	GetIndexersContext(ctx context.Context) ([]*IndexerOutput, error)
+	GetIndexer(indexerID int64) (*IndexerOutput, error)
+	GetIndexerContext(ctx context.Context, indexerID int64) (*IndexerOutput, error)
+	TestIndexer(indexer *IndexerInput) error
+	TestIndexerContext(ctx context.Context, indexer *IndexerInput) error
+	AddIndexer(indexer *IndexerInput) (*IndexerOutput, error)
+	AddIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error)
+	UpdateIndexer(indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	UpdateIndexerContext(ctx context.Context, indexer *IndexerInput, force bool) (*IndexerOutput, error)
+	DeleteIndexer(indexerID int64) error
+	DeleteIndexerContext(ctx context.Context, indexerID int64) error
+	GetIndexerSchema() ([]*IndexerOutput, error)
+	GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error)
+	UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error)
+	UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error)
+	DeleteBulkIndexers(ids []int64) error
+	DeleteBulkIndexersContext(ctx context.Context, ids []int64) error
+	TestAllIndexers() ([]*starr.ProviderTestResult, error)
+	TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error)
+}
+
+// MovieAPI contains the Whisparr methods for movies.
+type MovieAPI interface {
+	GetMovie(getMovie *GetMovie) ([]*Movie, error)
+	GetMovieContext(ctx context.Context, getMovie *GetMovie) ([]*Movie, error)
+	GetMovieByID(movieID int64) (*Movie, error)
+	GetMovieByIDContext(ctx context.Context, movieID int64) (*Movie, error)
+	UpdateMovie(movieID int64, movie *Movie, moveFiles bool) (*Movie, error)
+	UpdateMovieContext(ctx context.Context, movieID int64, movie *Movie, moveFiles bool) (*Movie, error)
+	AddMovie(movie *AddMovieInput) (*Movie, error)
+	AddMovieContext(ctx context.Context, movie *AddMovieInput) (*Movie, error)
+	Lookup(term string) ([]*Movie, error)
+	LookupContext(ctx context.Context, term string) ([]*Movie, error)
+	LookupID(movieID int64) (*Movie, error)
+	LookupIDContext(ctx context.Context, movieID int64) (*Movie, error)
+	LookupIMDB(imdbID string) (*Movie, error)
+	LookupIMDBContext(ctx context.Context, imdbID string) (*Movie, error)
+	LookupTMDB(tmdbID int64) (*Movie, error)
+	LookupTMDBContext(ctx context.Context, tmdbID int64) (*Movie, error)
+	DeleteMovie(movieID int64, deleteFiles, addImportExclusion bool) error
+	DeleteMovieContext(ctx context.Context, movieID int64, deleteFiles, addImportExclusion bool) error
+}
+
+// MovieEditorAPI contains the Whisparr methods for the movie editor.
+type MovieEditorAPI interface {
+	EditMovies(editMovies *BulkEdit) ([]*Movie, error)
+	EditMoviesContext(ctx context.Context, editMovies *BulkEdit) ([]*Movie, error)
+	DeleteMovies(deleteMovies *BulkEdit) error
+	DeleteMoviesContext(ctx context.Context, deleteMovies *BulkEdit) error
+}
+
+// MovieFileAPI contains the Whisparr methods for movie files.
+type MovieFileAPI interface {
+	GetMovieFile(movieID int64) ([]*MovieFile, error)
+	GetMovieFileContext(ctx context.Context, movieID int64) ([]*MovieFile, error)
+	GetMovieFileByID(movieFileID int64) (*MovieFile, error)
+	GetMovieFileByIDContext(ctx context.Context, movieFileID int64) (*MovieFile, error)
+	GetMovieFiles(movieFileIDs []int64) ([]*MovieFile, error)
+	GetMovieFilesContext(ctx context.Context, movieFileIDs []int64) ([]*MovieFile, error)
+	UpdateMovieFile(movieFile *MovieFile) (*MovieFile, error)
+	UpdateMovieFileContext(ctx context.Context, movieFile *MovieFile) (*MovieFile, error)
+	DeleteMovieFiles(movieFileIDs ...int64) error
+	DeleteMovieFilesContext(ctx context.Context, movieFileIDs ...int64) error
+}
+
+// QualityProfileAPI contains the Whisparr methods for quality profiles.
+type QualityProfileAPI interface {
+	GetQualityProfiles() ([]*QualityProfile, error)
+	GetQualityProfilesContext(ctx context.Context) ([]*QualityProfile, error)
+	GetQualityProfile(profileID int64) (*QualityProfile, error)
+	GetQualityProfileContext(ctx context.Context, profileID int64) (*QualityProfile, error)
+	AddQualityProfile(profile *QualityProfile) (*QualityProfile, error)
+	AddQualityProfileContext(ctx context.Context, profile *QualityProfile) (*QualityProfile, error)
+	UpdateQualityProfile(profile *QualityProfile) (*QualityProfile, error)
+	UpdateQualityProfileContext(ctx context.Context, profile *QualityProfile) (*QualityProfile, error)
+	DeleteQualityProfile(profileID int64) error
+	DeleteQualityProfileContext(ctx context.Context, profileID int64) error
+}
+
+// QueueAPI contains the Whisparr methods for the activity queue.
+type QueueAPI interface {
+	GetQueue(records, perPage int) (*Queue, error)
+	GetQueueContext(ctx context.Context, records, perPage int) (*Queue, error)
+	GetQueuePage(params *starr.PageReq) (*Queue, error)
+	GetQueuePageContext(ctx context.Context, params *starr.PageReq) (*Queue, error)
+	GetQueueDetails(movieID int64) ([]*QueueRecord, error)
+	GetQueueDetailsContext(ctx context.Context, movieID int64) ([]*QueueRecord, error)
+	GetQueueStatus() (*starr.QueueStatus, error)
+	GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error)
+	DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteQueueContext(ctx context.Context, queueID int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error
+	QueueGrab(ids ...int64) error
+	QueueGrabContext(ctx context.Context, ids ...int64) error
+}
+
+// SystemAPI contains the Whisparr methods for system status, backups, logs and other system endpoints.
+type SystemAPI interface {
+	GetSystemStatus() (*SystemStatus, error)
+	GetSystemStatusContext(ctx context.Context) (*SystemStatus, error)
+	GetBackupFiles() ([]*starr.BackupFile, error)
+	GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error)
+	Ping() error
+	PingContext(ctx context.Context) error
+	GetDiskSpace() ([]*starr.DiskSpace, error)
+	GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error)
+	GetHealth() ([]*starr.Health, error)
+	GetHealthContext(ctx context.Context) ([]*starr.Health, error)
+}
+
+// TagAPI contains the Whisparr methods for tags.
+type TagAPI interface {
+	GetTags() ([]*starr.Tag, error)
+	GetTagsContext(ctx context.Context) ([]*starr.Tag, error)
+	GetTag(tagID int) (*starr.Tag, error)
+	GetTagContext(ctx context.Context, tagID int) (*starr.Tag, error)
+	AddTag(tag *starr.Tag) (*starr.Tag, error)
+	AddTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	UpdateTag(tag *starr.Tag) (*starr.Tag, error)
+	UpdateTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error)
+	DeleteTag(tagID int) error
+	DeleteTagContext(ctx context.Context, tagID int) error
+}
@@ -0,0 +1,48 @@
+package whisparr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golift.io/starr"
+)
+
+// Define Base Path for Calendar queries.
+const bpCalendar = APIver + "/calendar"
+
+// Calendar defines the filters for fetching calendar items.
+// Start and End are required. Use starr.True() and starr.False() to fill in the booleans.
+type Calendar struct {
+	Start       time.Time
+	End         time.Time
+	Unmonitored bool
+}
+
+// GetCalendar returns calendars based on filters.
+func (w *Whisparr) GetCalendar(filter Calendar) ([]*Movie, error) {
+	return w.GetCalendarContext(context.Background(), filter)
+}
+
+// GetCalendarContext returns calendars based on filters.
+func (w *Whisparr) GetCalendarContext(ctx context.Context, filter Calendar) ([]*Movie, error) {
+	var output []*Movie
+
+	req := starr.Request{URI: bpCalendar, Query: make(url.Values)}
+	req.Query.Add("unmonitored", fmt.Sprint(filter.Unmonitored))
+
+	if !filter.Start.IsZero() {
+		req.Query.Add("start", filter.Start.UTC().Format(starr.CalendarTimeFilterFormat))
+	}
+
+	if !filter.End.IsZero() {
+		req.Query.Add("end", filter.End.UTC().Format(starr.CalendarTimeFilterFormat))
+	}
+
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,301 @@
+package whisparr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+)
+
+// Define Base Path for download client calls.
+const bpDownloadClient = APIver + "/downloadClient"
+
+// DownloadClientInput is the input for a new or updated download client.
+type DownloadClientInput struct {
+	Enable                   bool                `json:"enable"`
+	RemoveCompletedDownloads bool                `json:"removeCompletedDownloads"`
+	RemoveFailedDownloads    bool                `json:"removeFailedDownloads"`
+	Priority                 int                 `json:"priority"`
+	ID                       int64               `json:"id,omitempty"`
+	ConfigContract           string              `json:"configContract"`
+	Implementation           string              `json:"implementation"`
+	Name                     string              `json:"name"`
+	Protocol                 string              `json:"protocol"`
+	Tags                     []int               `json:"tags"`
+	Fields                   []*starr.FieldInput `json:"fields"`
+}
+
+// DownloadClientOutput is the output from the download client methods.
+type DownloadClientOutput struct {
+	Enable                   bool                 `json:"enable"`
+	RemoveCompletedDownloads bool                 `json:"removeCompletedDownloads"`
+	RemoveFailedDownloads    bool                 `json:"removeFailedDownloads"`
+	Priority                 int                  `json:"priority"`
+	ID                       int64                `json:"id,omitempty"`
+	ConfigContract           string               `json:"configContract"`
+	Implementation           string               `json:"implementation"`
+	ImplementationName       string               `json:"implementationName"`
+	InfoLink                 string               `json:"infoLink"`
+	Name                     string               `json:"name"`
+	Protocol                 string               `json:"protocol"`
+	Tags                     []int                `json:"tags"`
+	Fields                   []*starr.FieldOutput `json:"fields"`
+}
+
+// GetDownloadClients returns all configured download clients.
+func (w *Whisparr) GetDownloadClients() ([]*DownloadClientOutput, error) {
+	return w.GetDownloadClientsContext(context.Background())
+}
+
+// GetDownloadClientsContext returns all configured download clients.
+func (w *Whisparr) GetDownloadClientsContext(ctx context.Context) ([]*DownloadClientOutput, error) {
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: bpDownloadClient}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetDownloadClient returns a single download client.
+func (w *Whisparr) GetDownloadClient(downloadclientID int64) (*DownloadClientOutput, error) {
+	return w.GetDownloadClientContext(context.Background(), downloadclientID)
+}
+
+// GetDownloadClientContext returns a single download client.
+func (w *Whisparr) GetDownloadClientContext(ctx context.Context, downloadclientID int64) (*DownloadClientOutput, error) {
+	var output DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, fmt.Sprint(downloadclientID))}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddDownloadClient creates a download client without testing it.
+func (w *Whisparr) AddDownloadClient(downloadclient *DownloadClientInput) (*DownloadClientOutput, error) {
+	return w.AddDownloadClientContext(context.Background(), downloadclient)
+}
+
+// AddDownloadClientContext creates a download client without testing it.
+func (w *Whisparr) AddDownloadClientContext(ctx context.Context,
+	client *DownloadClientInput,
+) (*DownloadClientOutput, error) {
+	var (
+		output DownloadClientOutput
+		body   bytes.Buffer
+	)
+
+	client.ID = 0
+	if err := json.NewEncoder(&body).Encode(client); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: bpDownloadClient, Body: &body, Query: url.Values{"forceSave": []string{"true"}}}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// TestDownloadClient tests a download client.
+func (w *Whisparr) TestDownloadClient(client *DownloadClientInput) error {
+	return w.TestDownloadClientContext(context.Background(), client)
+}
+
+// TestDownloadClientContext tests a download client.
+func (w *Whisparr) TestDownloadClientContext(ctx context.Context, client *DownloadClientInput) error {
+	var output interface{} // any ok
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(client); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "test"), Body: &body}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// UpdateDownloadClient updates the download client.
+func (w *Whisparr) UpdateDownloadClient(downloadclient *DownloadClientInput, force bool) (*DownloadClientOutput, error) {
+	return w.UpdateDownloadClientContext(context.Background(), downloadclient, force)
+}
+
+// UpdateDownloadClientContext updates the download client.
+func (w *Whisparr) UpdateDownloadClientContext(ctx context.Context,
+	client *DownloadClientInput,
+	force bool,
+) (*DownloadClientOutput, error) {
+	var output DownloadClientOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(client); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{
+		URI:   path.Join(bpDownloadClient, fmt.Sprint(client.ID)),
+		Body:  &body,
+		Query: url.Values{"forceSave": []string{fmt.Sprint(force)}},
+	}
+	if err := w.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteDownloadClient removes a single download client.
+func (w *Whisparr) DeleteDownloadClient(downloadclientID int64) error {
+	return w.DeleteDownloadClientContext(context.Background(), downloadclientID)
+}
+
+// DeleteDownloadClientContext removes a single download client.
+func (w *Whisparr) DeleteDownloadClientContext(ctx context.Context, downloadclientID int64) error {
+	req := starr.Request{URI: path.Join(bpDownloadClient, fmt.Sprint(downloadclientID))}
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// GetDownloadClientSchema returns every download client implementation, and the fields each one accepts.
+// Use DownloadClientInputFromSchema to turn an entry into a new DownloadClientInput.
+func (w *Whisparr) GetDownloadClientSchema() ([]*DownloadClientOutput, error) {
+	return w.GetDownloadClientSchemaContext(context.Background())
+}
+
+// GetDownloadClientSchemaContext returns every download client implementation, and the fields each one accepts.
+func (w *Whisparr) GetDownloadClientSchemaContext(ctx context.Context) ([]*DownloadClientOutput, error) {
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "schema")}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DownloadClientInputFromSchema builds a DownloadClientInput from a schema entry and a map of field names to values.
+// Fields that are not in the map use the schema default. See starr.FieldsFromSchema for validation details.
+// The returned input is not nil when an error is returned, so it may still be inspected.
+func DownloadClientInputFromSchema(
+	schema *DownloadClientOutput,
+	values map[string]interface{},
+) (*DownloadClientInput, error) {
+	fields, err := starr.FieldsFromSchema(schema.Fields, values)
+	input := &DownloadClientInput{
+		Enable:                   schema.Enable,
+		RemoveCompletedDownloads: schema.RemoveCompletedDownloads,
+		RemoveFailedDownloads:    schema.RemoveFailedDownloads,
+		Priority:                 schema.Priority,
+		ConfigContract:           schema.ConfigContract,
+		Implementation:           schema.Implementation,
+		Name:                     schema.Name,
+		Protocol:                 schema.Protocol,
+		Tags:                     schema.Tags,
+		Fields:                   fields,
+	}
+
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", schema.Implementation, err)
+	}
+
+	return input, nil
+}
+
+// BulkDownloadClient is the input for the bulk download client editor, UpdateBulkDownloadClients.
+// Set IDs to the download clients you want to change, and set only the members you want to update.
+// You may use starr.True(), starr.False() and starr.Int64() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for ApplyTags.
+type BulkDownloadClient struct {
+	IDs                      []int64          `json:"ids"`
+	Tags                     []int            `json:"tags,omitempty"`
+	ApplyTags                *starr.ApplyTags `json:"applyTags,omitempty"`
+	Enable                   *bool            `json:"enable,omitempty"`
+	Priority                 *int64           `json:"priority,omitempty"`
+	RemoveCompletedDownloads *bool            `json:"removeCompletedDownloads,omitempty"`
+	RemoveFailedDownloads    *bool            `json:"removeFailedDownloads,omitempty"`
+}
+
+// UpdateBulkDownloadClients updates many download clients at once. Only the non-nil members of the input are changed.
+func (w *Whisparr) UpdateBulkDownloadClients(bulk *BulkDownloadClient) ([]*DownloadClientOutput, error) {
+	return w.UpdateBulkDownloadClientsContext(context.Background(), bulk)
+}
+
+// UpdateBulkDownloadClientsContext updates many download clients at once. Only the non-nil members of the input are changed.
+func (w *Whisparr) UpdateBulkDownloadClientsContext(ctx context.Context, bulk *BulkDownloadClient) ([]*DownloadClientOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	var output []*DownloadClientOutput
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := w.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBulkDownloadClients removes many download clients at once.
+func (w *Whisparr) DeleteBulkDownloadClients(ids []int64) error {
+	return w.DeleteBulkDownloadClientsContext(context.Background(), ids)
+}
+
+// DeleteBulkDownloadClientsContext removes many download clients at once.
+func (w *Whisparr) DeleteBulkDownloadClientsContext(ctx context.Context, ids []int64) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpDownloadClient, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "bulk"), Body: &body}
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllDownloadClients tests every configured download client, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (w *Whisparr) TestAllDownloadClients() ([]*starr.ProviderTestResult, error) {
+	return w.TestAllDownloadClientsContext(context.Background())
+}
+
+// TestAllDownloadClientsContext tests every configured download client, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (w *Whisparr) TestAllDownloadClientsContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var output []*starr.ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpDownloadClient, "testall")}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		if output, err = starr.ProviderTestResults(err); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	}
+
+	return output, nil
+}
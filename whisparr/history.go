@@ -0,0 +1,297 @@
+package whisparr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"golift.io/starr"
+)
+
+const bpHistory = APIver + "/history"
+
+// History is the /api/v3/history endpoint.
+type History struct {
+	Page          int              `json:"page"`
+	PageSize      int              `json:"pageSize"`
+	SortKey       string           `json:"sortKey"`
+	SortDirection string           `json:"sortDirection"`
+	TotalRecords  int              `json:"totalRecords"`
+	Records       []*HistoryRecord `json:"records"`
+}
+
+// HistoryRecord is part of the History data.
+// Not all items have all Data members. Check EventType for what you need.
+type HistoryRecord struct {
+	ID                  int64                 `json:"id"`
+	MovieID             int64                 `json:"movieId"`
+	SourceTitle         string                `json:"sourceTitle"`
+	Languages           []*starr.Value        `json:"languages"`
+	Quality             *starr.Quality        `json:"quality"`
+	CustomFormats       []*CustomFormatOutput `json:"customFormats"`
+	QualityCutoffNotMet bool                  `json:"qualityCutoffNotMet"`
+	Date                time.Time             `json:"date"`
+	DownloadID          string                `json:"downloadId"`
+	EventType           string                `json:"eventType"`
+	Data                struct {
+		Age                string    `json:"age"`
+		AgeHours           string    `json:"ageHours"`
+		AgeMinutes         string    `json:"ageMinutes"`
+		DownloadClient     string    `json:"downloadClient"`
+		DownloadClientName string    `json:"downloadClientName"`
+		DownloadURL        string    `json:"downloadUrl"`
+		DroppedPath        string    `json:"droppedPath"`
+		FileID             string    `json:"fileId"`
+		GUID               string    `json:"guid"`
+		ImportedPath       string    `json:"importedPath"`
+		Indexer            string    `json:"indexer"`
+		IndexerFlags       string    `json:"indexerFlags"`
+		IndexerID          string    `json:"indexerId"`
+		Message            string    `json:"message"`
+		NzbInfoURL         string    `json:"nzbInfoUrl"`
+		Path               string    `json:"path"`
+		Protocol           string    `json:"protocol"`
+		PublishedDate      time.Time `json:"publishedDate"`
+		Reason             string    `json:"reason"`
+		RelativePath       string    `json:"relativePath"`
+		ReleaseGroup       string    `json:"releaseGroup"`
+		Size               string    `json:"size"`
+		SourcePath         string    `json:"sourcePath"`
+		SourceRelativePath string    `json:"sourceRelativePath"`
+		TmdbID             string    `json:"tmdbId"`
+		TorrentInfoHash    string    `json:"torrentInfoHash"`
+	} `json:"data"`
+}
+
+// historyEvents maps the event types in a history record to their filters.
+var historyEvents = map[string]starr.Filtering{ //nolint:gochecknoglobals
+	"grabbed":                FilterGrabbed,
+	"downloadFolderImported": FilterDownloadFolderImported,
+	"movieFolderImported":    FilterFolderImported,
+	"downloadFailed":         FilterDownloadFailed,
+	"movieFileDeleted":       FilterFileDeleted,
+	"movieFileRenamed":       FilterRenamed,
+	"downloadIgnored":        FilterIgnored,
+}
+
+// Filter returns the filter for the record's event type, ie. FilterGrabbed.
+// Returns FilterUnknown if the event type is not known.
+func (h *HistoryRecord) Filter() starr.Filtering {
+	return historyEvents[h.EventType]
+}
+
+// Grabbed returns the data for a grabbed event, or nil if the record is another event type.
+func (h *HistoryRecord) Grabbed() *starr.GrabbedData {
+	if h.Filter() != FilterGrabbed {
+		return nil
+	}
+
+	size, _ := strconv.ParseInt(h.Data.Size, 10, 64) //nolint:gomnd
+	age, _ := strconv.ParseInt(h.Data.Age, 10, 64)   //nolint:gomnd
+
+	return &starr.GrabbedData{
+		Indexer:            h.Data.Indexer,
+		ReleaseGroup:       h.Data.ReleaseGroup,
+		Size:               size,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+		DownloadURL:        h.Data.DownloadURL,
+		GUID:               h.Data.GUID,
+		Protocol:           h.Data.Protocol,
+		PublishedDate:      h.Data.PublishedDate,
+		Age:                age,
+		NzbInfoURL:         h.Data.NzbInfoURL,
+		TorrentInfoHash:    h.Data.TorrentInfoHash,
+	}
+}
+
+// Imported returns the data for a download or folder imported event, or nil if the record is another event type.
+func (h *HistoryRecord) Imported() *starr.ImportedData {
+	if filter := h.Filter(); filter != FilterDownloadFolderImported && filter != FilterFolderImported {
+		return nil
+	}
+
+	return &starr.ImportedData{
+		DroppedPath:        h.Data.DroppedPath,
+		ImportedPath:       h.Data.ImportedPath,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+	}
+}
+
+// Failed returns the data for a failed download event, or nil if the record is another event type.
+func (h *HistoryRecord) Failed() *starr.FailedData {
+	if h.Filter() != FilterDownloadFailed {
+		return nil
+	}
+
+	size, _ := strconv.ParseInt(h.Data.Size, 10, 64) //nolint:gomnd
+
+	return &starr.FailedData{
+		Message:            h.Data.Message,
+		Indexer:            h.Data.Indexer,
+		ReleaseGroup:       h.Data.ReleaseGroup,
+		Size:               size,
+		DownloadClient:     h.Data.DownloadClient,
+		DownloadClientName: h.Data.DownloadClientName,
+		Protocol:           h.Data.Protocol,
+	}
+}
+
+// Deleted returns the data for a deleted file event, or nil if the record is another event type.
+func (h *HistoryRecord) Deleted() *starr.DeletedData {
+	if h.Filter() != FilterFileDeleted {
+		return nil
+	}
+
+	return &starr.DeletedData{Reason: h.Data.Reason}
+}
+
+// Renamed returns the data for a renamed file event, or nil if the record is another event type.
+func (h *HistoryRecord) Renamed() *starr.RenamedData {
+	if h.Filter() != FilterRenamed {
+		return nil
+	}
+
+	return &starr.RenamedData{
+		SourcePath:         h.Data.SourcePath,
+		SourceRelativePath: h.Data.SourceRelativePath,
+		Path:               h.Data.Path,
+		RelativePath:       h.Data.RelativePath,
+	}
+}
+
+// GetHistory returns the Whisparr History (grabs/failures/completed).
+// If you need control over the page, use whisparr.GetHistoryPage().
+// This function simply returns the number of history records desired,
+// up to the number of records present in the application.
+// It grabs records in (paginated) batches of perPage, and concatenates
+// them into one list. Passing zero for records will return all of them.
+func (w *Whisparr) GetHistory(records, perPage int) (*History, error) {
+	return w.GetHistoryContext(context.Background(), records, perPage)
+}
+
+// GetHistoryContext returns the Whisparr History (grabs/failures/completed).
+func (w *Whisparr) GetHistoryContext(ctx context.Context, records, perPage int) (*History, error) {
+	hist := &History{Records: []*HistoryRecord{}}
+	perPage = starr.SetPerPage(records, perPage)
+
+	for page := 1; ; page++ {
+		curr, err := w.GetHistoryPageContext(ctx, &starr.PageReq{PageSize: perPage, Page: page})
+		if err != nil {
+			return nil, err
+		}
+
+		hist.Records = append(hist.Records, curr.Records...)
+		if len(hist.Records) >= curr.TotalRecords ||
+			(len(hist.Records) >= records && records != 0) ||
+			len(curr.Records) == 0 {
+			hist.PageSize = curr.TotalRecords
+			hist.TotalRecords = curr.TotalRecords
+			hist.SortDirection = curr.SortDirection
+			hist.SortKey = curr.SortKey
+
+			break
+		}
+
+		perPage = starr.AdjustPerPage(records, curr.TotalRecords, len(hist.Records), perPage)
+	}
+
+	return hist, nil
+}
+
+// GetHistoryPage returns a single page from the Whisparr History (grabs/failures/completed).
+// The page size and number is configurable with the input request parameters.
+func (w *Whisparr) GetHistoryPage(params *starr.PageReq) (*History, error) {
+	return w.GetHistoryPageContext(context.Background(), params)
+}
+
+// GetHistoryPageContext returns a single page from the Whisparr History (grabs/failures/completed).
+// The page size and number is configurable with the input request parameters.
+func (w *Whisparr) GetHistoryPageContext(ctx context.Context, params *starr.PageReq) (*History, error) {
+	var output History
+
+	req := starr.Request{URI: bpHistory, Query: params.Params()}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetHistorySince returns all of the history records since a date.
+// Provide a filter, ie. FilterGrabbed, to return only that event type, or zero for all of them.
+func (w *Whisparr) GetHistorySince(date time.Time, filter starr.Filtering) ([]*HistoryRecord, error) {
+	return w.GetHistorySinceContext(context.Background(), date, filter)
+}
+
+// GetHistorySinceContext returns all of the history records since a date.
+func (w *Whisparr) GetHistorySinceContext(ctx context.Context,
+	date time.Time, filter starr.Filtering,
+) ([]*HistoryRecord, error) {
+	var output []*HistoryRecord
+
+	req := starr.Request{URI: path.Join(bpHistory, "since"), Query: make(url.Values)}
+	req.Query.Set("date", date.UTC().Format(time.RFC3339))
+
+	if filter > 0 {
+		req.Query.Set("eventType", filter.Param())
+	}
+
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetMovieHistory returns all of the history records for a single movie.
+// Provide a filter, ie. FilterGrabbed, to return only that event type, or zero for all of them.
+func (w *Whisparr) GetMovieHistory(movieID int64, filter starr.Filtering) ([]*HistoryRecord, error) {
+	return w.GetMovieHistoryContext(context.Background(), movieID, filter)
+}
+
+// GetMovieHistoryContext returns all of the history records for a single movie.
+func (w *Whisparr) GetMovieHistoryContext(ctx context.Context,
+	movieID int64, filter starr.Filtering,
+) ([]*HistoryRecord, error) {
+	var output []*HistoryRecord
+
+	req := starr.Request{URI: path.Join(bpHistory, "movie"), Query: make(url.Values)}
+	req.Query.Set("movieId", starr.Itoa(movieID))
+
+	if filter > 0 {
+		req.Query.Set("eventType", filter.Param())
+	}
+
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// Fail marks the given history item as failed by id.
+func (w *Whisparr) Fail(historyID int64) error {
+	return w.FailContext(context.Background(), historyID)
+}
+
+// FailContext marks the given history item as failed by id.
+func (w *Whisparr) FailContext(ctx context.Context, historyID int64) error {
+	if historyID < 1 {
+		return fmt.Errorf("%w: invalid history ID: %d", starr.ErrRequestError, historyID)
+	}
+
+	var output interface{} // any ok
+
+	// Strangely uses a POST without a payload.
+	req := starr.Request{URI: path.Join(bpHistory, "failed", fmt.Sprint(historyID))}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package whisparr_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/starrtest"
+	"golift.io/starr/whisparr"
+)
+
+func TestHistoryRecordData(t *testing.T) {
+	t.Parallel()
+
+	var records []*whisparr.HistoryRecord
+
+	err := json.Unmarshal([]byte(`[
+		{"eventType": "grabbed", "data": {"indexer": "Indexer", "downloadClient": "SABnzbd", "protocol": "usenet"}},
+		{"eventType": "movieFolderImported", "data": {"droppedPath": "/downloads/a.mkv", "importedPath": "/scenes/a.mkv"}},
+		{"eventType": "movieFileDeleted", "data": {"reason": "MissingFromDisk"}},
+		{"eventType": "movieFileRenamed", "data": {"sourcePath": "/a.mkv", "path": "/b.mkv"}},
+		{"eventType": "somethingNew", "data": {}}
+	]`), &records)
+	require.NoError(t, err)
+
+	assert.Equal(t, whisparr.FilterGrabbed, records[0].Filter())
+	assert.Equal(t, "Indexer", records[0].Grabbed().Indexer)
+	assert.Nil(t, records[0].Imported())
+
+	assert.Equal(t, whisparr.FilterFolderImported, records[1].Filter())
+	assert.Equal(t, &starr.ImportedData{DroppedPath: "/downloads/a.mkv", ImportedPath: "/scenes/a.mkv"}, records[1].Imported())
+
+	assert.Equal(t, whisparr.FilterFileDeleted, records[2].Filter())
+	assert.Equal(t, &starr.DeletedData{Reason: "MissingFromDisk"}, records[2].Deleted())
+
+	assert.Equal(t, whisparr.FilterRenamed, records[3].Filter())
+	assert.Equal(t, &starr.RenamedData{SourcePath: "/a.mkv", Path: "/b.mkv"}, records[3].Renamed())
+
+	assert.Equal(t, whisparr.FilterUnknown, records[4].Filter())
+}
+
+func TestGetHistorySince(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("test", -3600))
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "history/since?date=2023-01-02T04%3A04%3A05Z&eventType=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id": 1, "movieId": 3, "eventType": "movieFolderImported",
+				"data": {"importedPath": "/scenes/a.mkv"}}]`,
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "history/since?date=2023-01-02T04%3A04%3A05Z&eventType=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHistorySince(date, whisparr.FilterFolderImported)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+
+			if test.WithError == nil {
+				require.Len(t, output, 1)
+				assert.EqualValues(t, 3, output[0].MovieID)
+				assert.Equal(t, "/scenes/a.mkv", output[0].Imported().ImportedPath)
+			}
+		})
+	}
+}
+
+func TestGetMovieHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "history/movie?eventType=1&movieId=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "movieId": 7, "eventType": "grabbed"}]`,
+			WithResponse:   []*whisparr.HistoryRecord{{ID: 1, MovieID: 7, EventType: "grabbed"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "history/movie?eventType=1&movieId=7"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*whisparr.HistoryRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMovieHistory(7, whisparr.FilterGrabbed)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
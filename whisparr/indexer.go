@@ -0,0 +1,297 @@
+package whisparr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpIndexer = APIver + "/indexer"
+
+// IndexerInput is the input for a new or updated indexer.
+type IndexerInput struct {
+	EnableAutomaticSearch   bool                `json:"enableAutomaticSearch"`
+	EnableInteractiveSearch bool                `json:"enableInteractiveSearch"`
+	EnableRss               bool                `json:"enableRss"`
+	DownloadClientID        int64               `json:"downloadClientId"`
+	Priority                int64               `json:"priority"`
+	ID                      int64               `json:"id,omitempty"`
+	ConfigContract          string              `json:"configContract"`
+	Implementation          string              `json:"implementation"`
+	Name                    string              `json:"name"`
+	Protocol                string              `json:"protocol"`
+	Tags                    []int               `json:"tags"`
+	Fields                  []*starr.FieldInput `json:"fields"`
+}
+
+// IndexerOutput is the output from the indexer methods.
+type IndexerOutput struct {
+	EnableAutomaticSearch   bool                 `json:"enableAutomaticSearch"`
+	EnableInteractiveSearch bool                 `json:"enableInteractiveSearch"`
+	EnableRss               bool                 `json:"enableRss"`
+	SupportsRss             bool                 `json:"supportsRss"`
+	SupportsSearch          bool                 `json:"supportsSearch"`
+	DownloadClientID        int64                `json:"downloadClientId"`
+	Priority                int64                `json:"priority"`
+	ID                      int64                `json:"id,omitempty"`
+	ConfigContract          string               `json:"configContract"`
+	Implementation          string               `json:"implementation"`
+	ImplementationName      string               `json:"implementationName"`
+	InfoLink                string               `json:"infoLink"`
+	Name                    string               `json:"name"`
+	Protocol                string               `json:"protocol"`
+	Tags                    []int                `json:"tags"`
+	Fields                  []*starr.FieldOutput `json:"fields"`
+}
+
+// GetIndexers returns all configured indexers.
+func (w *Whisparr) GetIndexers() ([]*IndexerOutput, error) {
+	return w.GetIndexersContext(context.Background())
+}
+
+// GetIndexersContext returns all configured indexers.
+func (w *Whisparr) GetIndexersContext(ctx context.Context) ([]*IndexerOutput, error) {
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: bpIndexer}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetIndexer returns a single indexer.
+func (w *Whisparr) GetIndexer(indexerID int64) (*IndexerOutput, error) {
+	return w.GetIndexerContext(context.Background(), indexerID)
+}
+
+// GetIndexerContext returns a single indexer.
+func (w *Whisparr) GetIndexerContext(ctx context.Context, indexerID int64) (*IndexerOutput, error) {
+	var output IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, fmt.Sprint(indexerID))}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// TestIndexer tests an indexer.
+func (w *Whisparr) TestIndexer(indexer *IndexerInput) error {
+	return w.TestIndexerContext(context.Background(), indexer)
+}
+
+// TestIndexerContext tests an indexer.
+func (w *Whisparr) TestIndexerContext(ctx context.Context, indexer *IndexerInput) error {
+	var output interface{} // any ok
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, "test"), Body: &body}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// AddIndexer creates an indexer without testing it.
+func (w *Whisparr) AddIndexer(indexer *IndexerInput) (*IndexerOutput, error) {
+	return w.AddIndexerContext(context.Background(), indexer)
+}
+
+// AddIndexerContext creates an indexer without testing it.
+func (w *Whisparr) AddIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error) {
+	var (
+		output IndexerOutput
+		body   bytes.Buffer
+	)
+
+	indexer.ID = 0
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: bpIndexer, Body: &body, Query: url.Values{"forceSave": []string{"true"}}}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateIndexer updates the indexer.
+func (w *Whisparr) UpdateIndexer(indexer *IndexerInput, force bool) (*IndexerOutput, error) {
+	return w.UpdateIndexerContext(context.Background(), indexer, force)
+}
+
+// UpdateIndexerContext updates the indexer.
+func (w *Whisparr) UpdateIndexerContext(ctx context.Context, indexer *IndexerInput, force bool) (*IndexerOutput, error) {
+	var output IndexerOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{
+		URI:   path.Join(bpIndexer, fmt.Sprint(indexer.ID)),
+		Body:  &body,
+		Query: url.Values{"forceSave": []string{fmt.Sprint(force)}},
+	}
+	if err := w.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteIndexer removes a single indexer.
+func (w *Whisparr) DeleteIndexer(indexerID int64) error {
+	return w.DeleteIndexerContext(context.Background(), indexerID)
+}
+
+// DeleteIndexerContext removes a single indexer.
+func (w *Whisparr) DeleteIndexerContext(ctx context.Context, indexerID int64) error {
+	req := starr.Request{URI: path.Join(bpIndexer, fmt.Sprint(indexerID))}
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// GetIndexerSchema returns every indexer implementation, and the fields each one accepts.
+// Use IndexerInputFromSchema to turn an entry into a new IndexerInput.
+func (w *Whisparr) GetIndexerSchema() ([]*IndexerOutput, error) {
+	return w.GetIndexerSchemaContext(context.Background())
+}
+
+// GetIndexerSchemaContext returns every indexer implementation, and the fields each one accepts.
+func (w *Whisparr) GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error) {
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "schema")}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// IndexerInputFromSchema builds an IndexerInput from a schema entry and a map of field names to values.
+// Fields that are not in the map use the schema default. See starr.FieldsFromSchema for validation details.
+// The returned input is not nil when an error is returned, so it may still be inspected.
+func IndexerInputFromSchema(schema *IndexerOutput, values map[string]interface{}) (*IndexerInput, error) {
+	fields, err := starr.FieldsFromSchema(schema.Fields, values)
+	input := &IndexerInput{
+		EnableAutomaticSearch:   schema.EnableAutomaticSearch,
+		EnableInteractiveSearch: schema.EnableInteractiveSearch,
+		EnableRss:               schema.EnableRss,
+		DownloadClientID:        schema.DownloadClientID,
+		Priority:                schema.Priority,
+		ConfigContract:          schema.ConfigContract,
+		Implementation:          schema.Implementation,
+		Name:                    schema.Name,
+		Protocol:                schema.Protocol,
+		Tags:                    schema.Tags,
+		Fields:                  fields,
+	}
+
+	if err != nil {
+		return input, fmt.Errorf("%s: %w", schema.Implementation, err)
+	}
+
+	return input, nil
+}
+
+// BulkIndexer is the input for the bulk indexer editor, UpdateBulkIndexers.
+// Set IDs to the indexers you want to change, and set only the members you want to update.
+// You may use starr.True(), starr.False() and starr.Int64() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for ApplyTags.
+type BulkIndexer struct {
+	IDs                     []int64          `json:"ids"`
+	Tags                    []int            `json:"tags,omitempty"`
+	ApplyTags               *starr.ApplyTags `json:"applyTags,omitempty"`
+	EnableRss               *bool            `json:"enableRss,omitempty"`
+	EnableAutomaticSearch   *bool            `json:"enableAutomaticSearch,omitempty"`
+	EnableInteractiveSearch *bool            `json:"enableInteractiveSearch,omitempty"`
+	Priority                *int64           `json:"priority,omitempty"`
+}
+
+// UpdateBulkIndexers updates many indexers at once. Only the non-nil members of the input are changed.
+func (w *Whisparr) UpdateBulkIndexers(bulk *BulkIndexer) ([]*IndexerOutput, error) {
+	return w.UpdateBulkIndexersContext(context.Background(), bulk)
+}
+
+// UpdateBulkIndexersContext updates many indexers at once. Only the non-nil members of the input are changed.
+func (w *Whisparr) UpdateBulkIndexersContext(ctx context.Context, bulk *BulkIndexer) ([]*IndexerOutput, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bulk); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := w.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBulkIndexers removes many indexers at once.
+func (w *Whisparr) DeleteBulkIndexers(ids []int64) error {
+	return w.DeleteBulkIndexersContext(context.Background(), ids)
+}
+
+// DeleteBulkIndexersContext removes many indexers at once.
+func (w *Whisparr) DeleteBulkIndexersContext(ctx context.Context, ids []int64) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, "bulk"), Body: &body}
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllIndexers tests every configured indexer, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (w *Whisparr) TestAllIndexers() ([]*starr.ProviderTestResult, error) {
+	return w.TestAllIndexersContext(context.Background())
+}
+
+// TestAllIndexersContext tests every configured indexer, and returns the result for each one.
+// A failed test does not return an error; check IsValid and ValidationFailures in each result.
+func (w *Whisparr) TestAllIndexersContext(ctx context.Context) ([]*starr.ProviderTestResult, error) {
+	var output []*starr.ProviderTestResult
+
+	req := starr.Request{URI: path.Join(bpIndexer, "testall")}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		if output, err = starr.ProviderTestResults(err); err != nil {
+			return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,318 @@
+package whisparr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"golift.io/starr"
+)
+
+const bpMovie = APIver + "/movie"
+
+// ItemType is used to tell movies and scenes apart. Both are returned from the movie endpoint.
+type ItemType string
+
+// These are the known item types.
+const (
+	ItemTypeMovie ItemType = "movie"
+	ItemTypeScene ItemType = "scene"
+)
+
+// Movie is the /api/v3/movie endpoint. Whisparr stores movies and scenes in this type. Check ItemType.
+type Movie struct {
+	ID                    int64               `json:"id"`
+	ItemType              ItemType            `json:"itemType,omitempty"`
+	ForeignID             string              `json:"foreignId,omitempty"`
+	StashID               string              `json:"stashId,omitempty"`
+	Code                  string              `json:"code,omitempty"`
+	Title                 string              `json:"title,omitempty"`
+	Path                  string              `json:"path,omitempty"`
+	MinimumAvailability   Availability        `json:"minimumAvailability,omitempty"`
+	QualityProfileID      int64               `json:"qualityProfileId,omitempty"`
+	TmdbID                int64               `json:"tmdbId,omitempty"`
+	OriginalTitle         string              `json:"originalTitle,omitempty"`
+	AlternateTitles       []*AlternativeTitle `json:"alternateTitles,omitempty"`
+	SecondaryYearSourceID int                 `json:"secondaryYearSourceId,omitempty"`
+	SortTitle             string              `json:"sortTitle,omitempty"`
+	SizeOnDisk            int64               `json:"sizeOnDisk,omitempty"`
+	Status                string              `json:"status,omitempty"`
+	Overview              string              `json:"overview,omitempty"`
+	InCinemas             time.Time           `json:"inCinemas,omitempty"`
+	PhysicalRelease       time.Time           `json:"physicalRelease,omitempty"`
+	DigitalRelease        time.Time           `json:"digitalRelease,omitempty"`
+	ReleaseDate           string              `json:"releaseDate,omitempty"`
+	Images                []*starr.Image      `json:"images,omitempty"`
+	Website               string              `json:"website,omitempty"`
+	Year                  int                 `json:"year,omitempty"`
+	YouTubeTrailerID      string              `json:"youTubeTrailerId,omitempty"`
+	Studio                string              `json:"studio,omitempty"`
+	StudioTitle           string              `json:"studioTitle,omitempty"`
+	StudioForeignID       string              `json:"studioForeignId,omitempty"`
+	FolderName            string              `json:"folderName,omitempty"`
+	Runtime               int                 `json:"runtime,omitempty"`
+	CleanTitle            string              `json:"cleanTitle,omitempty"`
+	ImdbID                string              `json:"imdbId,omitempty"`
+	TitleSlug             string              `json:"titleSlug,omitempty"`
+	Certification         string              `json:"certification,omitempty"`
+	Genres                []string            `json:"genres,omitempty"`
+	Tags                  []int               `json:"tags,omitempty"`
+	Added                 time.Time           `json:"added,omitempty"`
+	Ratings               starr.OpenRatings   `json:"ratings,omitempty"`
+	MovieFile             *MovieFile          `json:"movieFile,omitempty"`
+	Collection            *Collection         `json:"collection,omitempty"`
+	HasFile               bool                `json:"hasFile,omitempty"`
+	IsAvailable           bool                `json:"isAvailable,omitempty"`
+	Monitored             bool                `json:"monitored"`
+	Popularity            float64             `json:"popularity"`
+	OriginalLanguage      *starr.Value        `json:"originalLanguage,omitempty"`
+	AddOptions            *AddMovieOptions    `json:"addOptions,omitempty"` // only available upon adding a movie.
+}
+
+// Collection belongs to a Movie.
+type Collection struct {
+	Name   string         `json:"name"`
+	TmdbID int64          `json:"tmdbId"`
+	Images []*starr.Image `json:"images"`
+}
+
+// AddMovieInput is the input for a new movie or scene.
+// Movies are found by TmdbID, and scenes are found by ForeignID (or StashID).
+type AddMovieInput struct {
+	ItemType            ItemType         `json:"itemType,omitempty"`
+	ForeignID           string           `json:"foreignId,omitempty"`
+	StashID             string           `json:"stashId,omitempty"`
+	Title               string           `json:"title,omitempty"`
+	TitleSlug           string           `json:"titleSlug,omitempty"`
+	MinimumAvailability Availability     `json:"minimumAvailability,omitempty"`
+	RootFolderPath      string           `json:"rootFolderPath"`
+	TmdbID              int64            `json:"tmdbId,omitempty"`
+	QualityProfileID    int64            `json:"qualityProfileId"`
+	ProfileID           int64            `json:"profileId,omitempty"`
+	Year                int              `json:"year,omitempty"`
+	Images              []*starr.Image   `json:"images,omitempty"`
+	AddOptions          *AddMovieOptions `json:"addOptions"`
+	Tags                []int            `json:"tags,omitempty"`
+	Monitored           bool             `json:"monitored"`
+}
+
+// AddMovieOptions are the options for finding a new movie.
+type AddMovieOptions struct {
+	SearchForMovie bool `json:"searchForMovie"`
+	// Allowed values: "movieOnly", "movieAndCollection", "none"
+	Monitor string `json:"monitor,omitempty"`
+}
+
+// AlternativeTitle is part of a Movie.
+type AlternativeTitle struct {
+	MovieMetadataID int64        `json:"movieMetadataId"`
+	MovieID         int64        `json:"movieId"`
+	Title           string       `json:"title"`
+	SourceType      string       `json:"sourceType"`
+	SourceID        int64        `json:"sourceId"`
+	Votes           int          `json:"votes"`
+	VoteCount       int          `json:"voteCount"`
+	Language        *starr.Value `json:"language"`
+	ID              int64        `json:"id"`
+}
+
+// IsScene returns true if the item is a scene, and not a movie.
+func (m *Movie) IsScene() bool {
+	return m.ItemType == ItemTypeScene
+}
+
+// GetMovie represents the input parameters for a movie api request.
+type GetMovie struct {
+	// Set TMDBID to retrieve a single movie. Leave it at 0 to retrieve them all.
+	TMDBID int64
+	// Setting this to true may speed up the response time, but less data is returned.
+	ExcludeLocalCovers bool
+}
+
+// GetMovie grabs a movie from the queue, or all movies if tmdbId is 0.
+func (w *Whisparr) GetMovie(getMovie *GetMovie) ([]*Movie, error) {
+	return w.GetMovieContext(context.Background(), getMovie)
+}
+
+// GetMovieContext grabs a movie from the queue, or all movies if tmdbId is 0.
+func (w *Whisparr) GetMovieContext(ctx context.Context, getMovie *GetMovie) ([]*Movie, error) {
+	if getMovie == nil {
+		getMovie = &GetMovie{}
+	}
+
+	params := make(url.Values)
+	if getMovie.TMDBID != 0 {
+		params.Set("tmdbId", fmt.Sprint(getMovie.TMDBID))
+	} else {
+		// excludeLocalCovers can only be true without a tmdbid.
+		params.Set("excludeLocalCovers", fmt.Sprint(getMovie.ExcludeLocalCovers))
+	}
+
+	var output []*Movie
+
+	req := starr.Request{URI: bpMovie, Query: params}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetMovieByID grabs a movie from the database by DB [movie] ID.
+func (w *Whisparr) GetMovieByID(movieID int64) (*Movie, error) {
+	return w.GetMovieByIDContext(context.Background(), movieID)
+}
+
+// GetMovieByIDContext grabs a movie from the database by DB [movie] ID.
+func (w *Whisparr) GetMovieByIDContext(ctx context.Context, movieID int64) (*Movie, error) {
+	var output Movie
+
+	req := starr.Request{URI: path.Join(bpMovie, fmt.Sprint(movieID))}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateMovie sends a PUT request to update a movie in place.
+func (w *Whisparr) UpdateMovie(movieID int64, movie *Movie, moveFiles bool) (*Movie, error) {
+	return w.UpdateMovieContext(context.Background(), movieID, movie, moveFiles)
+}
+
+// UpdateMovieContext sends a PUT request to update a movie in place.
+func (w *Whisparr) UpdateMovieContext(ctx context.Context, movieID int64, movie *Movie, moveFiles bool) (*Movie, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(movie); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMovie, err)
+	}
+
+	var output Movie
+
+	req := starr.Request{
+		URI:   path.Join(bpMovie, fmt.Sprint(movieID)),
+		Query: make(url.Values),
+		Body:  &body,
+	}
+	req.Query.Add("moveFiles", fmt.Sprint(moveFiles))
+
+	if err := w.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddMovie adds a movie to the queue.
+func (w *Whisparr) AddMovie(movie *AddMovieInput) (*Movie, error) {
+	return w.AddMovieContext(context.Background(), movie)
+}
+
+// AddMovieContext adds a movie to the queue.
+func (w *Whisparr) AddMovieContext(ctx context.Context, movie *AddMovieInput) (*Movie, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(movie); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMovie, err)
+	}
+
+	var output Movie
+
+	req := starr.Request{URI: bpMovie, Query: make(url.Values), Body: &body}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// Lookup will search for movies matching the specified search term.
+func (w *Whisparr) Lookup(term string) ([]*Movie, error) {
+	return w.LookupContext(context.Background(), term)
+}
+
+// LookupContext will search for movies matching the specified search term.
+func (w *Whisparr) LookupContext(ctx context.Context, term string) ([]*Movie, error) {
+	var output []*Movie
+
+	if term == "" {
+		return output, nil
+	}
+
+	req := starr.Request{URI: path.Join(bpMovie, "lookup"), Query: make(url.Values)}
+	req.Query.Set("term", term)
+
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// LookupID will return a movie by its ID.
+func (w *Whisparr) LookupID(movieID int64) (*Movie, error) {
+	return w.LookupIDContext(context.Background(), movieID)
+}
+
+// LookupIDContext will return a movie by its ID using a context.
+func (w *Whisparr) LookupIDContext(ctx context.Context, movieID int64) (*Movie, error) {
+	return w.lookupSubContext(ctx, fmt.Sprint(movieID), "", "")
+}
+
+// LookupIMDB will search IMDB for the imdbId provided.
+func (w *Whisparr) LookupIMDB(imdbID string) (*Movie, error) {
+	return w.LookupIMDBContext(context.Background(), imdbID)
+}
+
+// LookupIMDBContext will search IMDB for the imdbId provided using a context.
+func (w *Whisparr) LookupIMDBContext(ctx context.Context, imdbID string) (*Movie, error) {
+	return w.lookupSubContext(ctx, "imdb", "imdbId", imdbID)
+}
+
+// LookupTMDB will search TMDB for the tmdbID provided.
+func (w *Whisparr) LookupTMDB(tmdbID int64) (*Movie, error) {
+	return w.LookupTMDBContext(context.Background(), tmdbID)
+}
+
+// LookupTMDBContext will search TMDB for the tmdbID provided using a context.
+func (w *Whisparr) LookupTMDBContext(ctx context.Context, tmdbID int64) (*Movie, error) {
+	return w.lookupSubContext(ctx, "tmdb", "tmdbId", fmt.Sprint(tmdbID))
+}
+
+// lookupSubContext abstracts lookup requests.
+func (w *Whisparr) lookupSubContext(ctx context.Context, sub, name, val string) (*Movie, error) {
+	var output *Movie
+
+	req := starr.Request{URI: path.Join(bpMovie, "lookup", sub), Query: make(url.Values)}
+
+	if name != "" {
+		req.Query.Set(name, val)
+	}
+
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteMovie removes a movie from the database. Setting deleteFiles true will delete all content for the movie.
+func (w *Whisparr) DeleteMovie(movieID int64, deleteFiles, addImportExclusion bool) error {
+	return w.DeleteMovieContext(context.Background(), movieID, deleteFiles, addImportExclusion)
+}
+
+// DeleteMovieContext removes a movie from the database. Setting deleteFiles true will delete all content for the movie.
+func (w *Whisparr) DeleteMovieContext(ctx context.Context, movieID int64, deleteFiles, addImportExclusion bool) error {
+	req := starr.Request{URI: path.Join(bpMovie, fmt.Sprint(movieID)), Query: make(url.Values)}
+	req.Query.Set("deleteFiles", fmt.Sprint(deleteFiles))
+	req.Query.Set("addImportExclusion", fmt.Sprint(addImportExclusion))
+
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
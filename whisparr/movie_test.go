@@ -0,0 +1,100 @@
+package whisparr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/starrtest"
+	"golift.io/starr/whisparr"
+)
+
+func TestGetMovie(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "movie?excludeLocalCovers=false"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":1,"itemType":"movie","title":"Some Movie","tmdbId":55,"monitored":true},` +
+				`{"id":2,"itemType":"scene","title":"Some Scene","foreignId":"abc","stashId":"def",` +
+				`"studioTitle":"Studio","releaseDate":"2023-01-02","monitored":false}]`,
+			WithResponse: []*whisparr.Movie{
+				{ID: 1, ItemType: whisparr.ItemTypeMovie, Title: "Some Movie", TmdbID: 55, Monitored: true},
+				{
+					ID:          2,
+					ItemType:    whisparr.ItemTypeScene,
+					Title:       "Some Scene",
+					ForeignID:   "abc",
+					StashID:     "def",
+					StudioTitle: "Studio",
+					ReleaseDate: "2023-01-02",
+				},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "movie?excludeLocalCovers=false"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*whisparr.Movie)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMovie(nil)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+
+			for _, movie := range output {
+				assert.Equal(t, movie.ItemType == whisparr.ItemTypeScene, movie.IsScene())
+			}
+		})
+	}
+}
+
+func TestAddMovie(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "movie"),
+		ExpectedMethod: http.MethodPost,
+		ExpectedRequest: `{"itemType":"scene","foreignId":"abc","title":"Some Scene","rootFolderPath":"/scenes",` +
+			`"qualityProfileId":1,"addOptions":{"searchForMovie":true},"monitored":true}` + "\n",
+		ResponseStatus: http.StatusCreated,
+		ResponseBody:   `{"id":2,"itemType":"scene","foreignId":"abc","title":"Some Scene","monitored":true}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.AddMovie(&whisparr.AddMovieInput{
+		ItemType:         whisparr.ItemTypeScene,
+		ForeignID:        "abc",
+		Title:            "Some Scene",
+		RootFolderPath:   "/scenes",
+		QualityProfileID: 1,
+		AddOptions:       &whisparr.AddMovieOptions{SearchForMovie: true},
+		Monitored:        true,
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, &whisparr.Movie{
+		ID:        2,
+		ItemType:  whisparr.ItemTypeScene,
+		ForeignID: "abc",
+		Title:     "Some Scene",
+		Monitored: true,
+	}, output)
+	assert.True(t, output.IsScene())
+}
@@ -0,0 +1,97 @@
+package whisparr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/starrtest"
+	"golift.io/starr/whisparr"
+)
+
+func TestEditMovies(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "movie", "editor"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 7, "monitored": true},{"id": 3, "monitored": true}]`,
+			WithError:      nil,
+			WithRequest: &whisparr.BulkEdit{
+				MovieIDs:    []int64{7, 3},
+				Monitored:   starr.True(),
+				DeleteFiles: starr.False(),
+			},
+			ExpectedRequest: `{"movieIds":[7,3],"monitored":true,"deleteFiles":false}` + "\n",
+			ExpectedMethod:  http.MethodPut,
+			WithResponse:    []*whisparr.Movie{{ID: 7, Monitored: true}, {ID: 3, Monitored: true}},
+		},
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "movie", "editor"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":17,"minimumAvailability":"tba","tags":[44,55,66]},` +
+				`{"id":13,"minimumAvailability":"tba","tags":[44,55,66]}]`,
+			WithError: nil,
+			WithRequest: &whisparr.BulkEdit{
+				MovieIDs:            []int64{17, 13},
+				Tags:                []int{44, 55, 66},
+				ApplyTags:           starr.TagsAdd.Ptr(),
+				MinimumAvailability: whisparr.AvailabilityToBeAnnounced.Ptr(),
+			},
+			ExpectedRequest: `{"movieIds":[17,13],"minimumAvailability":"tba","tags":[44,55,66],"applyTags":"add"}` + "\n",
+			ExpectedMethod:  http.MethodPut,
+			WithResponse: []*whisparr.Movie{
+				{ID: 17, MinimumAvailability: whisparr.AvailabilityToBeAnnounced, Tags: []int{44, 55, 66}},
+				{ID: 13, MinimumAvailability: whisparr.AvailabilityToBeAnnounced, Tags: []int{44, 55, 66}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditMovies(test.WithRequest.(*whisparr.BulkEdit))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestDeleteMovies(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "movie", "editor"),
+			ResponseStatus: http.StatusOK,
+			WithError:      nil,
+			WithRequest: &whisparr.BulkEdit{
+				MovieIDs:    []int64{7, 3},
+				Monitored:   starr.False(),
+				DeleteFiles: starr.True(),
+			},
+			ExpectedRequest: `{"movieIds":[7,3],"monitored":false,"deleteFiles":true}` + "\n",
+			ExpectedMethod:  http.MethodDelete,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteMovies(test.WithRequest.(*whisparr.BulkEdit))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+		})
+	}
+}
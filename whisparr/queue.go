@@ -0,0 +1,223 @@
+package whisparr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"golift.io/starr"
+)
+
+const bpQueue = APIver + "/queue"
+
+// Queue is the /api/v3/queue endpoint.
+type Queue struct {
+	Page          int            `json:"page"`
+	PageSize      int            `json:"pageSize"`
+	SortKey       string         `json:"sortKey"`
+	SortDirection string         `json:"sortDirection"`
+	TotalRecords  int            `json:"totalRecords"`
+	Records       []*QueueRecord `json:"records"`
+}
+
+// QueueRecord is part of the activity Queue.
+type QueueRecord struct {
+	HasPostImportCategory   bool                   `json:"downloadClientHasPostImportCategory"`
+	MovieID                 int64                  `json:"movieId"`
+	Languages               []*starr.Value         `json:"languages"`
+	Quality                 *starr.Quality         `json:"quality"`
+	CustomFormats           []*CustomFormatOutput  `json:"customFormats"`
+	Size                    float64                `json:"size"`
+	Title                   string                 `json:"title"`
+	Sizeleft                float64                `json:"sizeleft"`
+	Timeleft                string                 `json:"timeleft"`
+	EstimatedCompletionTime time.Time              `json:"estimatedCompletionTime"`
+	Status                  string                 `json:"status"`
+	TrackedDownloadStatus   string                 `json:"trackedDownloadStatus"`
+	TrackedDownloadState    string                 `json:"trackedDownloadState"`
+	StatusMessages          []*starr.StatusMessage `json:"statusMessages"`
+	DownloadID              string                 `json:"downloadId"`
+	Protocol                string                 `json:"protocol"`
+	DownloadClient          string                 `json:"downloadClient"`
+	Indexer                 string                 `json:"indexer"`
+	OutputPath              string                 `json:"outputPath"`
+	ID                      int64                  `json:"id"`
+	ErrorMessage            string                 `json:"errorMessage"`
+}
+
+// GetQueue returns a single page from the Whisparr Queue (processing, but not yet imported).
+// If you need control over the page, use whisparr.GetQueuePage().
+// This function simply returns the number of queue records desired,
+// up to the number of records present in the application.
+// It grabs records in (paginated) batches of perPage, and concatenates
+// them into one list.  Passing zero for records will return all of them.
+func (w *Whisparr) GetQueue(records, perPage int) (*Queue, error) {
+	return w.GetQueueContext(context.Background(), records, perPage)
+}
+
+// GetQueueContext returns a single page from the Whisparr Queue (processing, but not yet imported).
+func (w *Whisparr) GetQueueContext(ctx context.Context, records, perPage int) (*Queue, error) {
+	queue := &Queue{Records: []*QueueRecord{}}
+	perPage = starr.SetPerPage(records, perPage)
+
+	for page := 1; ; page++ {
+		curr, err := w.GetQueuePageContext(ctx, &starr.PageReq{PageSize: perPage, Page: page})
+		if err != nil {
+			return nil, err
+		}
+
+		queue.Records = append(queue.Records, curr.Records...)
+		if len(queue.Records) >= curr.TotalRecords ||
+			(len(queue.Records) >= records && records != 0) ||
+			len(curr.Records) == 0 {
+			queue.PageSize = curr.TotalRecords
+			queue.TotalRecords = curr.TotalRecords
+			queue.SortDirection = curr.SortDirection
+			queue.SortKey = curr.SortKey
+
+			break
+		}
+
+		perPage = starr.AdjustPerPage(records, curr.TotalRecords, len(queue.Records), perPage)
+	}
+
+	return queue, nil
+}
+
+// GetQueuePage returns a single page from the Whisparr Queue.
+// The page size and number is configurable with the input request parameters.
+func (w *Whisparr) GetQueuePage(params *starr.PageReq) (*Queue, error) {
+	return w.GetQueuePageContext(context.Background(), params)
+}
+
+// GetQueuePage returns a single page from the Whisparr Queue.
+// The page size and number is configurable with the input request parameters.
+func (w *Whisparr) GetQueuePageContext(ctx context.Context, params *starr.PageReq) (*Queue, error) {
+	var output Queue
+
+	params.CheckSet("sortKey", "timeleft")
+	params.CheckSet("includeUnknownMovieItems", "true")
+
+	req := starr.Request{URI: bpQueue, Query: params.Params()}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(queue): %w", err)
+	}
+
+	return &output, nil
+}
+
+// GetQueueDetails returns every record in the Activity Queue without paging.
+// Provide a movie ID to return only the records for that movie. A zero movie ID
+// returns all of them, including items that are not matched to one.
+func (w *Whisparr) GetQueueDetails(movieID int64) ([]*QueueRecord, error) {
+	return w.GetQueueDetailsContext(context.Background(), movieID)
+}
+
+// GetQueueDetailsContext returns every record in the Activity Queue without paging.
+func (w *Whisparr) GetQueueDetailsContext(ctx context.Context,
+	movieID int64,
+) ([]*QueueRecord, error) {
+	var output []*QueueRecord
+
+	req := starr.Request{URI: path.Join(bpQueue, "details"), Query: make(url.Values)}
+	req.Query.Set("includeUnknownMovieItems", "true")
+
+	if movieID > 0 {
+		req.Query.Set("movieId", starr.Itoa(movieID))
+	}
+
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetQueueStatus returns the number of records in the Activity Queue, and if any of them have errors or warnings.
+func (w *Whisparr) GetQueueStatus() (*starr.QueueStatus, error) {
+	return w.GetQueueStatusContext(context.Background())
+}
+
+// GetQueueStatusContext returns the number of records in the Activity Queue, and if any have errors or warnings.
+func (w *Whisparr) GetQueueStatusContext(ctx context.Context) (*starr.QueueStatus, error) {
+	var output starr.QueueStatus
+
+	req := starr.Request{URI: path.Join(bpQueue, "status")}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteQueue deletes an item from the Activity Queue.
+func (w *Whisparr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
+	return w.DeleteQueueContext(context.Background(), queueID, opts)
+}
+
+// DeleteQueueContext deletes an item from the Activity Queue.
+func (w *Whisparr) DeleteQueueContext(ctx context.Context, queueID int64, opts *starr.QueueDeleteOpts) error {
+	req := starr.Request{URI: path.Join(bpQueue, fmt.Sprint(queueID)), Query: opts.Values()}
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteBulkQueue deletes many items from the Activity Queue at once.
+// The delete options are applied to every item.
+func (w *Whisparr) DeleteBulkQueue(queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	return w.DeleteBulkQueueContext(context.Background(), queueIDs, opts)
+}
+
+// DeleteBulkQueueContext deletes many items from the Activity Queue at once.
+func (w *Whisparr) DeleteBulkQueueContext(ctx context.Context, queueIDs []int64, opts *starr.QueueDeleteOpts) error {
+	input := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: queueIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpQueue, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpQueue, "bulk"), Query: opts.Values(), Body: &body}
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// QueueGrab tells the app to grab an item that's in queue.
+// Most often used on items with a delay set from a delay profile.
+func (w *Whisparr) QueueGrab(ids ...int64) error {
+	return w.QueueGrabContext(context.Background(), ids...)
+}
+
+// QueueGrabContext tells the app to grab an item that's in queue, probably set to a delay.
+// Most often used on items with a delay set from a delay profile.
+func (w *Whisparr) QueueGrabContext(ctx context.Context, ids ...int64) error {
+	idList := struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(idList); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpQueue, err)
+	}
+
+	var output interface{} // any ok
+
+	req := starr.Request{URI: path.Join(bpQueue, "grab", "bulk"), Body: &body}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package whisparr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr"
+	"golift.io/starr/starrtest"
+	"golift.io/starr/whisparr"
+)
+
+func TestGetQueuePage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, whisparr.APIver,
+				"queue?includeUnknownMovieItems=true&page=1&pageSize=10&sortDirection=descending&sortKey=timeleft"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page": 1, "pageSize": 10, "sortKey": "timeleft", "sortDirection": "descending",
+				"totalRecords": 1, "records": [{"id": 1, "movieId": 3, "title": "Studio.23.01.02.Scene.Title"}]}`,
+			WithResponse: &whisparr.Queue{
+				Page: 1, PageSize: 10, SortKey: "timeleft", SortDirection: "descending", TotalRecords: 1,
+				Records: []*whisparr.QueueRecord{{ID: 1, MovieID: 3, Title: "Studio.23.01.02.Scene.Title"}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, whisparr.APIver,
+				"queue?includeUnknownMovieItems=true&page=1&pageSize=10&sortDirection=descending&sortKey=timeleft"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*whisparr.Queue)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetQueuePage(&starr.PageReq{
+				Page: 1, PageSize: 10, SortKey: "timeleft", SortDir: starr.SortDescend,
+			})
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetQueueDetails(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "queue/details?includeUnknownMovieItems=true&movieId=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 1, "movieId": 3, "title": "Studio.23.01.02.Scene.Title"}]`,
+			WithResponse:   []*whisparr.QueueRecord{{ID: 1, MovieID: 3, Title: "Studio.23.01.02.Scene.Title"}},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "queue/details?includeUnknownMovieItems=true&movieId=3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*whisparr.QueueRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetQueueDetails(3)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetQueueStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "queue/status"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"totalCount": 3, "count": 2, "unknownCount": 1, "warnings": true}`,
+			WithResponse:   &starr.QueueStatus{TotalCount: 3, Count: 2, UnknownCount: 1, Warnings: true},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, whisparr.APIver, "queue/status"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*starr.QueueStatus)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetQueueStatus()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteBulkQueue(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, whisparr.APIver, "queue/bulk?blocklist=false&changeCategory=false&removeFromClient=true&skipRedownload=false"),
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"ids":[4,5]}` + "\n",
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, whisparr.APIver, "queue/bulk?blocklist=false&changeCategory=false&removeFromClient=true&skipRedownload=false"),
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"ids":[4,5]}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteBulkQueue([]int64{4, 5}, &starr.QueueDeleteOpts{RemoveFromClient: starr.True()})
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
+
+func TestQueueGrab(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, whisparr.APIver, "queue/grab/bulk"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `{"ids":[4,5]}` + "\n",
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    "{}",
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, whisparr.APIver, "queue/grab/bulk"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `{"ids":[4,5]}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := whisparr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.QueueGrab(4, 5)
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package whisparr
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"golift.io/starr"
+)
+
+const (
+	bpSystem    = APIver + "/system"
+	bpDiskSpace = APIver + "/diskspace"
+	bpHealth    = APIver + "/health"
+)
+
+// SystemStatus is the /api/v3/system/status endpoint.
+type SystemStatus struct {
+	AppData                string    `json:"appData"`
+	AppName                string    `json:"appName"`
+	Authentication         string    `json:"authentication"`
+	Branch                 string    `json:"branch"`
+	BuildTime              time.Time `json:"buildTime"`
+	DatabaseType           string    `json:"databaseType"`
+	DatabaseVersion        string    `json:"databaseVersion"`
+	InstanceName           string    `json:"instanceName"`
+	IsAdmin                bool      `json:"isAdmin"`
+	IsDebug                bool      `json:"isDebug"`
+	IsDocker               bool      `json:"isDocker"`
+	IsLinux                bool      `json:"isLinux"`
+	IsNetCore              bool      `json:"isNetCore"`
+	IsOsx                  bool      `json:"isOsx"`
+	IsProduction           bool      `json:"isProduction"`
+	IsUserInteractive      bool      `json:"isUserInteractive"`
+	IsWindows              bool      `json:"isWindows"`
+	MigrationVersion       int64     `json:"migrationVersion"`
+	Mode                   string    `json:"mode"`
+	OsName                 string    `json:"osName"`
+	PackageAuthor          string    `json:"packageAuthor"`
+	PackageUpdateMechanism string    `json:"packageUpdateMechanism"`
+	PackageVersion         string    `json:"packageVersion"`
+	RuntimeName            string    `json:"runtimeName"`
+	RuntimeVersion         string    `json:"runtimeVersion"`
+	StartTime              time.Time `json:"startTime"`
+	StartupPath            string    `json:"startupPath"`
+	URLBase                string    `json:"urlBase"`
+	Version                string    `json:"version"`
+}
+
+// GetSystemStatus returns system status.
+func (w *Whisparr) GetSystemStatus() (*SystemStatus, error) {
+	return w.GetSystemStatusContext(context.Background())
+}
+
+// GetSystemStatusContext returns system status.
+func (w *Whisparr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, error) {
+	var output SystemStatus
+
+	req := starr.Request{URI: path.Join(bpSystem, "status")}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%v): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetBackupFiles returns all available Whisparr backup files.
+// Use GetBody to download a file using BackupFile.Path.
+func (w *Whisparr) GetBackupFiles() ([]*starr.BackupFile, error) {
+	return w.GetBackupFilesContext(context.Background())
+}
+
+// GetBackupFilesContext returns all available Whisparr backup files.
+// Use GetBody to download a file using BackupFile.Path.
+func (w *Whisparr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
+	var output []*starr.BackupFile
+
+	req := starr.Request{URI: path.Join(bpSystem, "backup")}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetDiskSpace returns the free and total space for every disk Whisparr uses.
+func (w *Whisparr) GetDiskSpace() ([]*starr.DiskSpace, error) {
+	return w.GetDiskSpaceContext(context.Background())
+}
+
+// GetDiskSpaceContext returns the free and total space for every disk Whisparr uses.
+func (w *Whisparr) GetDiskSpaceContext(ctx context.Context) ([]*starr.DiskSpace, error) {
+	var output []*starr.DiskSpace
+
+	req := starr.Request{URI: bpDiskSpace}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetHealth returns the health check warnings and errors from Whisparr.
+func (w *Whisparr) GetHealth() ([]*starr.Health, error) {
+	return w.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns the health check warnings and errors from Whisparr.
+func (w *Whisparr) GetHealthContext(ctx context.Context) ([]*starr.Health, error) {
+	var output []*starr.Health
+
+	req := starr.Request{URI: bpHealth}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
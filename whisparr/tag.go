@@ -0,0 +1,106 @@
+package whisparr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpTag = APIver + "/tag"
+
+// GetTags returns all configured tags.
+func (w *Whisparr) GetTags() ([]*starr.Tag, error) {
+	return w.GetTagsContext(context.Background())
+}
+
+// GetTagsContext returns all configured tags.
+func (w *Whisparr) GetTagsContext(ctx context.Context) ([]*starr.Tag, error) {
+	var output []*starr.Tag
+
+	req := starr.Request{URI: bpTag}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetTag returns a single tag.
+func (w *Whisparr) GetTag(tagID int) (*starr.Tag, error) {
+	return w.GetTagContext(context.Background(), tagID)
+}
+
+// GetTagContext returns a single tag.
+func (w *Whisparr) GetTagContext(ctx context.Context, tagID int) (*starr.Tag, error) {
+	var output starr.Tag
+
+	req := starr.Request{URI: path.Join(bpTag, fmt.Sprint(tagID))}
+	if err := w.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddTag creates a tag.
+func (w *Whisparr) AddTag(tag *starr.Tag) (*starr.Tag, error) {
+	return w.AddTagContext(context.Background(), tag)
+}
+
+// AddTagContext creates a tag.
+func (w *Whisparr) AddTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error) {
+	var output starr.Tag
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(tag); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpTag, err)
+	}
+
+	req := starr.Request{URI: bpTag, Body: &body}
+	if err := w.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateTag updates a tag.
+func (w *Whisparr) UpdateTag(tag *starr.Tag) (*starr.Tag, error) {
+	return w.UpdateTagContext(context.Background(), tag)
+}
+
+// UpdateTagContext updates a tag.
+func (w *Whisparr) UpdateTagContext(ctx context.Context, tag *starr.Tag) (*starr.Tag, error) {
+	var output starr.Tag
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(tag); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpTag, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpTag, fmt.Sprint(tag.ID)), Body: &body}
+	if err := w.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteTag removes a single tag.
+func (w *Whisparr) DeleteTag(tagID int) error {
+	return w.DeleteTagContext(context.Background(), tagID)
+}
+
+// DeleteTagContext removes a single tag.
+func (w *Whisparr) DeleteTagContext(ctx context.Context, tagID int) error {
+	req := starr.Request{URI: path.Join(bpTag, fmt.Sprint(tagID))}
+	if err := w.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
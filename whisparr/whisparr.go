@@ -0,0 +1,66 @@
+package whisparr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golift.io/starr"
+)
+
+// APIver is the Whisparr API version supported by this library.
+const APIver = "v3"
+
+// Whisparr contains all the methods to interact with a Whisparr server.
+// This package targets Whisparr v3 (the Radarr fork, API v3).
+// It stores movies and scenes in the same Movie type.
+type Whisparr struct {
+	starr.APIer
+}
+
+// Filter values are integers. Given names for ease of discovery.
+// These are the same values Radarr uses.
+const (
+	FilterUnknown starr.Filtering = iota
+	FilterGrabbed
+	_ // 2 is unused
+	FilterDownloadFolderImported
+	FilterDownloadFailed
+	_ // 5 is unused. FilterDeleted
+	FilterFileDeleted
+	FilterFolderImported
+	FilterRenamed
+	FilterIgnored
+)
+
+// New returns a Whisparr object used to interact with the Whisparr API.
+func New(config *starr.Config) *Whisparr {
+	if config.Client == nil {
+		config.Client = starr.Client(0, false)
+	}
+
+	config.URL = strings.TrimSuffix(config.URL, "/")
+
+	return &Whisparr{APIer: config}
+}
+
+// bp means base path. You'll see it a lot in these files.
+const bpPing = "/ping" // ping has no api or version prefix.
+
+// Ping returns an error if the starr instance does not respond with a 200 to an HTTP /ping request.
+func (w *Whisparr) Ping() error {
+	return w.PingContext(context.Background())
+}
+
+// PingContext returns an error if the starr instance does not respond with a 200 to an HTTP /ping request.
+func (w *Whisparr) PingContext(ctx context.Context) error {
+	req := starr.Request{URI: bpPing}
+
+	resp, err := w.Get(ctx, req)
+	if err != nil {
+		return fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}